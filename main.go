@@ -4,6 +4,8 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
@@ -29,10 +31,30 @@ func main() {
 	newrelic.UserAgentServiceName = UserAgentServiceName
 
 	var debugMode bool
+	var migrateAlertChannels bool
+	var statePath string
 
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
+	flag.BoolVar(&migrateAlertChannels, "migrate-alert-channels", false, "print the notification destinations, channels and workflows replacing the legacy alert channels as HCL, then exit")
+	flag.StringVar(&statePath, "state", "", "the Terraform state file to read legacy alert channels from with -migrate-alert-channels, instead of the account configured by the NEW_RELIC_* environment variables")
 	flag.Parse()
 
+	if migrateAlertChannels {
+		accountID, _ := strconv.Atoi(os.Getenv("NEW_RELIC_ACCOUNT_ID"))
+
+		err := newrelic.MigrateAlertChannels(context.Background(), newrelic.AlertChannelMigrationOptions{
+			StatePath:      statePath,
+			AccountID:      accountID,
+			PersonalAPIKey: os.Getenv("NEW_RELIC_API_KEY"),
+			Region:         os.Getenv("NEW_RELIC_REGION"),
+		}, os.Stdout)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/newrelic/newrelic",
 			&plugin.ServeOpts{
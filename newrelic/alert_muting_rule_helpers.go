@@ -0,0 +1,713 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	// Embeds the IANA time zone database, so that schedule time zones can be validated
+	// on hosts without one.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+)
+
+// The number of upcoming windows of a muting rule schedule exported as `next_occurrences`.
+const mutingRuleScheduleNextOccurrencesCount = 5
+
+// The arguments of the schedule block of a muting rule.
+var mutingRuleScheduleAttributes = []string{"end_repeat", "end_time", "repeat", "repeat_count", "start_time", "time_zone", "weekly_repeat_days"}
+
+// Reports whether the attributes of an incident match the condition group of a muting rule.
+func evaluateMutingRuleConditionGroup(group alerts.MutingRuleConditionGroup, attributes map[string][]string) bool {
+	or := strings.EqualFold(group.Operator, "OR")
+
+	for _, condition := range group.Conditions {
+		matched := evaluateMutingRuleCondition(condition, attributes)
+		if or && matched {
+			return true
+		}
+		if !or && !matched {
+			return false
+		}
+	}
+
+	return !or && len(group.Conditions) > 0
+}
+
+// Evaluates a muting rule condition against the attributes of an incident. Attributes may
+// hold several values, e.g. tags, in which case a positive operator matches when any value
+// of the attribute matches, and a negative operator matches when its positive counterpart
+// does not.
+func evaluateMutingRuleCondition(condition alerts.MutingRuleCondition, attributes map[string][]string) bool {
+	values := attributes[condition.Attribute]
+
+	anyMatch := func(match func(value string, expected string) bool) bool {
+		for _, value := range values {
+			for _, expected := range condition.Values {
+				if match(value, expected) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	equals := func(value string, expected string) bool { return value == expected }
+
+	blank := true
+	for _, value := range values {
+		if value != "" {
+			blank = false
+		}
+	}
+
+	switch strings.ToUpper(condition.Operator) {
+	case "EQUALS", "IN", "ANY":
+		return anyMatch(equals)
+	case "NOT_EQUALS", "NOT_IN":
+		return !anyMatch(equals)
+	case "CONTAINS":
+		return anyMatch(strings.Contains)
+	case "NOT_CONTAINS":
+		return !anyMatch(strings.Contains)
+	case "STARTS_WITH":
+		return anyMatch(strings.HasPrefix)
+	case "NOT_STARTS_WITH":
+		return !anyMatch(strings.HasPrefix)
+	case "ENDS_WITH":
+		return anyMatch(strings.HasSuffix)
+	case "NOT_ENDS_WITH":
+		return !anyMatch(strings.HasSuffix)
+	case "IS_BLANK":
+		return blank
+	case "IS_NOT_BLANK":
+		return !blank
+	}
+
+	return false
+}
+
+// Calls fn with the start and end of each window of a muting rule schedule, in chronological
+// order, until fn returns false or the schedule stops repeating. A missing start or end time
+// of a schedule which does not repeat is represented by the zero time.
+func forEachMutingRuleScheduleWindow(schedule alerts.MutingRuleScheduleCreateInput, fn func(start time.Time, end time.Time) bool) error {
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return fmt.Errorf("invalid schedule time zone `%s`: %v", schedule.TimeZone, err)
+	}
+
+	inLocation := func(t *alerts.NaiveDateTime) time.Time {
+		if t == nil {
+			return time.Time{}
+		}
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, location)
+	}
+
+	start := inLocation(schedule.StartTime)
+	end := inLocation(schedule.EndTime)
+
+	if schedule.Repeat == nil {
+		fn(start, end)
+		return nil
+	}
+
+	if start.IsZero() || end.IsZero() {
+		return fmt.Errorf("a repeating schedule requires both `start_time` and `end_time`")
+	}
+
+	if !end.After(start) {
+		return fmt.Errorf("schedule `end_time` must be after `start_time`")
+	}
+
+	duration := end.Sub(start)
+	endRepeat := inLocation(schedule.EndRepeat)
+
+	repeatCount := 0
+	if schedule.RepeatCount != nil {
+		repeatCount = *schedule.RepeatCount
+	}
+
+	weekdays := map[time.Weekday]bool{}
+	if schedule.WeeklyRepeatDays != nil {
+		for _, day := range *schedule.WeeklyRepeatDays {
+			for w := time.Sunday; w <= time.Saturday; w++ {
+				if strings.EqualFold(w.String(), string(day)) {
+					weekdays[w] = true
+				}
+			}
+		}
+	}
+	if len(weekdays) == 0 {
+		weekdays[start.Weekday()] = true
+	}
+
+	occurrences := 0
+	for i := 0; ; i++ {
+		var occurrence time.Time
+
+		switch *schedule.Repeat {
+		case alerts.MutingRuleScheduleRepeatTypes.DAILY:
+			occurrence = start.AddDate(0, 0, i)
+		case alerts.MutingRuleScheduleRepeatTypes.WEEKLY:
+			occurrence = start.AddDate(0, 0, i)
+			if !weekdays[occurrence.Weekday()] {
+				continue
+			}
+		case alerts.MutingRuleScheduleRepeatTypes.MONTHLY:
+			occurrence = start.AddDate(0, i, 0)
+		default:
+			return fmt.Errorf("unsupported schedule repeat `%s`", *schedule.Repeat)
+		}
+
+		if !endRepeat.IsZero() && occurrence.After(endRepeat) {
+			return nil
+		}
+
+		if repeatCount > 0 && occurrences >= repeatCount {
+			return nil
+		}
+		occurrences++
+
+		if !fn(occurrence, occurrence.Add(duration)) {
+			return nil
+		}
+	}
+}
+
+// Reports whether a muting rule schedule is active at the given time.
+func isMutingRuleScheduleActive(schedule alerts.MutingRuleScheduleCreateInput, at time.Time) (bool, error) {
+	active := false
+
+	err := forEachMutingRuleScheduleWindow(schedule, func(start time.Time, end time.Time) bool {
+		if !start.IsZero() && start.After(at) {
+			return false
+		}
+
+		if end.IsZero() || end.After(at) {
+			active = true
+			return false
+		}
+
+		return true
+	})
+
+	return active, err
+}
+
+// Validates the combination of arguments of a muting rule schedule, which the API either
+// rejects or silently ignores.
+func validateMutingRuleSchedule(schedule alerts.MutingRuleScheduleCreateInput) error {
+	if schedule.TimeZone == "" || strings.EqualFold(schedule.TimeZone, "Local") {
+		return fmt.Errorf("schedule `time_zone` must be an IANA time zone, e.g. `America/Los_Angeles`")
+	}
+
+	if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+		return fmt.Errorf("schedule `time_zone` `%s` is not a known IANA time zone", schedule.TimeZone)
+	}
+
+	if schedule.StartTime != nil && schedule.EndTime != nil && !schedule.EndTime.After(schedule.StartTime.Time) {
+		return fmt.Errorf("schedule `end_time` must be after `start_time`")
+	}
+
+	if schedule.WeeklyRepeatDays != nil && len(*schedule.WeeklyRepeatDays) > 0 &&
+		(schedule.Repeat == nil || *schedule.Repeat != alerts.MutingRuleScheduleRepeatTypes.WEEKLY) {
+		return fmt.Errorf("schedule `weekly_repeat_days` requires `repeat` to be `WEEKLY`")
+	}
+
+	if schedule.EndRepeat != nil && schedule.RepeatCount != nil {
+		return fmt.Errorf("schedule `end_repeat` and `repeat_count` cannot both be set")
+	}
+
+	if schedule.Repeat == nil {
+		if schedule.EndRepeat != nil || schedule.RepeatCount != nil {
+			return fmt.Errorf("schedule `end_repeat` and `repeat_count` require `repeat` to be set")
+		}
+
+		return nil
+	}
+
+	if schedule.StartTime == nil || schedule.EndTime == nil {
+		return fmt.Errorf("a repeating schedule requires both `start_time` and `end_time`")
+	}
+
+	if schedule.EndRepeat != nil && !schedule.EndRepeat.After(schedule.StartTime.Time) {
+		return fmt.Errorf("schedule `end_repeat` must be after `start_time`")
+	}
+
+	return nil
+}
+
+// Returns the start times of the windows of a muting rule schedule which have not ended at
+// the given time, in the time zone of the schedule.
+func mutingRuleScheduleNextOccurrences(schedule alerts.MutingRuleScheduleCreateInput, now time.Time, count int) ([]string, error) {
+	occurrences := []string{}
+
+	err := forEachMutingRuleScheduleWindow(schedule, func(start time.Time, end time.Time) bool {
+		if start.IsZero() || (!end.IsZero() && !end.After(now)) {
+			return true
+		}
+
+		occurrences = append(occurrences, start.Format(time.RFC3339))
+
+		return len(occurrences) < count
+	})
+
+	return occurrences, err
+}
+
+// Returns the next occurrences of the schedule of a muting rule, given its `schedule` block,
+// `ical_event` and `rrule` arguments.
+func expandMutingRuleScheduleNextOccurrences(s []interface{}, icalEvent string, rrule string) ([]string, error) {
+	cfg, err := mutingRuleScheduleConfig(s, icalEvent, rrule)
+	if err != nil || cfg == nil {
+		return []string{}, err
+	}
+
+	schedule, err := expandMutingRuleCreateSchedule(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return mutingRuleScheduleNextOccurrences(schedule, time.Now(), mutingRuleScheduleNextOccurrencesCount)
+}
+
+// Validates the schedule of a muting rule at plan time and computes its next occurrences
+// when it changes.
+func customizeDiffMutingRuleSchedule(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("schedule", "ical_event", "rrule") {
+		return nil
+	}
+
+	if !d.NewValueKnown("ical_event") || !d.NewValueKnown("rrule") {
+		return d.SetNewComputed("next_occurrences")
+	}
+
+	for _, attribute := range mutingRuleScheduleAttributes {
+		if !d.NewValueKnown("schedule.0." + attribute) {
+			return d.SetNewComputed("next_occurrences")
+		}
+	}
+
+	cfg, err := mutingRuleScheduleConfig(d.Get("schedule").([]interface{}), d.Get("ical_event").(string), d.Get("rrule").(string))
+	if err != nil {
+		return err
+	}
+
+	if cfg == nil {
+		return d.SetNew("next_occurrences", []string{})
+	}
+
+	schedule, err := expandMutingRuleCreateSchedule(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := validateMutingRuleSchedule(schedule); err != nil {
+		return err
+	}
+
+	occurrences, err := mutingRuleScheduleNextOccurrences(schedule, time.Now(), mutingRuleScheduleNextOccurrencesCount)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("next_occurrences", occurrences)
+}
+
+// The RFC 5545 week days, and the days of the week of a muting rule schedule they represent.
+var mutingRuleRRuleWeekDays = map[string]string{
+	"MO": "MONDAY",
+	"TU": "TUESDAY",
+	"WE": "WEDNESDAY",
+	"TH": "THURSDAY",
+	"FR": "FRIDAY",
+	"SA": "SATURDAY",
+	"SU": "SUNDAY",
+}
+
+// Returns the arguments of the schedule block of a muting rule, given its `schedule` block,
+// `ical_event` and `rrule` arguments. Returns nil when the muting rule has no schedule.
+func mutingRuleScheduleConfig(schedule []interface{}, icalEvent string, rrule string) (map[string]interface{}, error) {
+	if icalEvent != "" {
+		return parseMutingRuleICalEvent(icalEvent)
+	}
+
+	if len(schedule) == 0 || schedule[0] == nil {
+		return nil, nil
+	}
+
+	cfg := map[string]interface{}{}
+	for k, v := range schedule[0].(map[string]interface{}) {
+		cfg[k] = v
+	}
+
+	if rrule != "" {
+		if err := applyMutingRuleRRule(rrule, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+// Parses an RFC 5545 recurrence rule into the repeat arguments of a muting rule schedule.
+// Recurrences which a muting rule schedule cannot represent are rejected.
+func applyMutingRuleRRule(rrule string, cfg map[string]interface{}) error {
+	rrule = strings.TrimSpace(rrule)
+	if len(rrule) > 6 && strings.EqualFold(rrule[:6], "RRULE:") {
+		rrule = rrule[6:]
+	}
+
+	parts := map[string]string{}
+	for _, part := range strings.Split(rrule, ";") {
+		if part == "" {
+			continue
+		}
+
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return fmt.Errorf("invalid RRULE part `%s`, expected NAME=VALUE", part)
+		}
+
+		parts[strings.ToUpper(kv[0])] = strings.ToUpper(kv[1])
+	}
+
+	freq := parts["FREQ"]
+	if freq == "" {
+		return fmt.Errorf("RRULE requires a FREQ")
+	}
+
+	for name, value := range parts {
+		switch name {
+		case "FREQ", "BYDAY", "COUNT", "UNTIL", "WKST":
+		case "INTERVAL":
+			if value != "1" {
+				return fmt.Errorf("RRULE INTERVAL=%s cannot be represented by a muting rule schedule, which repeats every day, week or month", value)
+			}
+		default:
+			return fmt.Errorf("RRULE %s cannot be represented by a muting rule schedule, which only supports FREQ, BYDAY, COUNT and UNTIL", name)
+		}
+	}
+
+	days := []interface{}{}
+	if byDay, ok := parts["BYDAY"]; ok {
+		for _, day := range strings.Split(byDay, ",") {
+			weekDay, ok := mutingRuleRRuleWeekDays[day]
+			if !ok {
+				return fmt.Errorf("RRULE BYDAY=%s cannot be represented by a muting rule schedule, which repeats on week days without ordinals", byDay)
+			}
+			days = append(days, weekDay)
+		}
+
+		switch freq {
+		case "WEEKLY":
+		case "DAILY":
+			// Repeating daily on some days of the week is repeating weekly on those days.
+			freq = "WEEKLY"
+		default:
+			return fmt.Errorf("RRULE BYDAY cannot be represented by a muting rule schedule with FREQ=%s", freq)
+		}
+	}
+
+	switch freq {
+	case "DAILY", "WEEKLY", "MONTHLY":
+	default:
+		return fmt.Errorf("RRULE FREQ=%s cannot be represented by a muting rule schedule, which repeats DAILY, WEEKLY or MONTHLY", freq)
+	}
+
+	cfg["repeat"] = freq
+	cfg["weekly_repeat_days"] = schema.NewSet(schema.HashString, days)
+	cfg["repeat_count"] = 0
+	cfg["end_repeat"] = ""
+
+	count, hasCount := parts["COUNT"]
+	until, hasUntil := parts["UNTIL"]
+
+	if hasCount && hasUntil {
+		return fmt.Errorf("RRULE COUNT and UNTIL cannot both be set")
+	}
+
+	if hasCount {
+		var repeatCount int
+		if _, err := fmt.Sscanf(count, "%d", &repeatCount); err != nil || repeatCount < 1 || fmt.Sprint(repeatCount) != count {
+			return fmt.Errorf("invalid RRULE COUNT=%s, expected a positive integer", count)
+		}
+		cfg["repeat_count"] = repeatCount
+	}
+
+	if hasUntil {
+		timeZone, _ := cfg["time_zone"].(string)
+		location, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return fmt.Errorf("invalid schedule time zone `%s`: %v", timeZone, err)
+		}
+
+		endRepeat, err := parseICalDateTime(until, location)
+		if err != nil {
+			return fmt.Errorf("invalid RRULE UNTIL: %v", err)
+		}
+
+		if len(until) == len("20060102") {
+			// UNTIL is inclusive, a date stops repeating at the end of that day.
+			endRepeat = endRepeat.Add(24*time.Hour - time.Second)
+		}
+
+		cfg["end_repeat"] = endRepeat.In(location).Format("2006-01-02T15:04:05")
+	}
+
+	return nil
+}
+
+// An iCalendar content line, e.g. `DTSTART;TZID=Europe/Paris:20260105T090000`.
+type iCalProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// Parses the content lines of an iCalendar document, unfolding long lines.
+func parseICalProperties(document string) ([]iCalProperty, error) {
+	document = strings.ReplaceAll(document, "\r\n", "\n")
+
+	lines := []string{}
+	for _, line := range strings.Split(document, "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+
+	properties := []iCalProperty{}
+	for _, line := range lines {
+		quoted := false
+		separator := -1
+		for i, c := range line {
+			if c == '"' {
+				quoted = !quoted
+			}
+			if c == ':' && !quoted {
+				separator = i
+				break
+			}
+		}
+
+		if separator < 0 {
+			return nil, fmt.Errorf("invalid iCalendar line `%s`, expected NAME:VALUE", line)
+		}
+
+		nameAndParams := strings.Split(line[:separator], ";")
+		property := iCalProperty{
+			Name:   strings.ToUpper(nameAndParams[0]),
+			Params: map[string]string{},
+			Value:  line[separator+1:],
+		}
+
+		for _, param := range nameAndParams[1:] {
+			kv := strings.SplitN(param, "=", 2)
+			if len(kv) == 2 {
+				property.Params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+			}
+		}
+
+		properties = append(properties, property)
+	}
+
+	return properties, nil
+}
+
+// Parses an iCalendar date or date-time value in the given location. Date-times in UTC are
+// returned in UTC.
+func parseICalDateTime(value string, location *time.Location) (time.Time, error) {
+	switch {
+	case len(value) == len("20060102"):
+		return time.ParseInLocation("20060102", value, location)
+	case strings.HasSuffix(value, "Z"):
+		return time.Parse("20060102T150405Z", value)
+	default:
+		return time.ParseInLocation("20060102T150405", value, location)
+	}
+}
+
+// Parses an RFC 5545 VEVENT into the arguments of the schedule block of a muting rule. The
+// DTSTART and DTEND of the event define the first window of the schedule and its RRULE how
+// it repeats.
+func parseMutingRuleICalEvent(document string) (map[string]interface{}, error) {
+	properties, err := parseICalProperties(document)
+	if err != nil {
+		return nil, err
+	}
+
+	events := 0
+	depth := 0
+	calendarTimeZone := ""
+	event := map[string]iCalProperty{}
+
+	for _, property := range properties {
+		switch property.Name {
+		case "BEGIN":
+			if depth == 0 && strings.EqualFold(property.Value, "VEVENT") {
+				events++
+				depth = 1
+			} else if depth > 0 {
+				depth++
+			}
+			continue
+		case "END":
+			if depth > 0 {
+				depth--
+			}
+			continue
+		case "X-WR-TIMEZONE":
+			calendarTimeZone = property.Value
+			continue
+		}
+
+		// Properties of nested components, e.g. alarms, do not apply to the event.
+		if depth != 1 || events > 1 {
+			continue
+		}
+
+		switch property.Name {
+		case "RDATE", "EXDATE", "EXRULE":
+			return nil, fmt.Errorf("iCalendar %s cannot be represented by a muting rule schedule", property.Name)
+		case "DURATION":
+			return nil, fmt.Errorf("iCalendar DURATION is not supported, use DTEND to end the event")
+		case "RRULE":
+			if _, ok := event[property.Name]; ok {
+				return nil, fmt.Errorf("iCalendar events with several RRULE cannot be represented by a muting rule schedule")
+			}
+		}
+
+		event[property.Name] = property
+	}
+
+	if events == 0 {
+		return nil, fmt.Errorf("iCalendar document has no VEVENT")
+	}
+
+	if events > 1 {
+		return nil, fmt.Errorf("iCalendar document has %d VEVENT, a muting rule schedule is defined by a single event", events)
+	}
+
+	dtStart, ok := event["DTSTART"]
+	if !ok {
+		return nil, fmt.Errorf("iCalendar event has no DTSTART")
+	}
+
+	timeZone := dtStart.Params["TZID"]
+	switch {
+	case timeZone != "":
+	case strings.HasSuffix(dtStart.Value, "Z"):
+		timeZone = "UTC"
+	case calendarTimeZone != "":
+		timeZone = calendarTimeZone
+	default:
+		return nil, fmt.Errorf("iCalendar DTSTART must have a TZID parameter or be in UTC")
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("iCalendar time zone `%s` is not a known IANA time zone", timeZone)
+	}
+
+	start, err := parseICalDateTime(dtStart.Value, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid iCalendar DTSTART: %v", err)
+	}
+
+	cfg := map[string]interface{}{
+		"time_zone":          timeZone,
+		"start_time":         start.In(location).Format("2006-01-02T15:04:05"),
+		"end_time":           "",
+		"repeat":             "",
+		"repeat_count":       0,
+		"end_repeat":         "",
+		"weekly_repeat_days": schema.NewSet(schema.HashString, []interface{}{}),
+	}
+
+	if dtEnd, ok := event["DTEND"]; ok {
+		endLocation := location
+		if tzid := dtEnd.Params["TZID"]; tzid != "" {
+			if endLocation, err = time.LoadLocation(tzid); err != nil {
+				return nil, fmt.Errorf("iCalendar time zone `%s` is not a known IANA time zone", tzid)
+			}
+		}
+
+		end, err := parseICalDateTime(dtEnd.Value, endLocation)
+		if err != nil {
+			return nil, fmt.Errorf("invalid iCalendar DTEND: %v", err)
+		}
+
+		cfg["end_time"] = end.In(location).Format("2006-01-02T15:04:05")
+	} else if len(dtStart.Value) == len("20060102") {
+		// An all-day event without DTEND lasts for the day of DTSTART.
+		cfg["end_time"] = start.AddDate(0, 0, 1).Format("2006-01-02T15:04:05")
+	}
+
+	if rrule, ok := event["RRULE"]; ok {
+		if err := applyMutingRuleRRule(rrule.Value, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+func validateMutingRuleICalEvent(val interface{}, key string) (warns []string, errs []error) {
+	if _, err := parseMutingRuleICalEvent(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%#v is not a supported iCalendar event: %v", key, err))
+	}
+	return
+}
+
+func validateMutingRuleRRule(val interface{}, key string) (warns []string, errs []error) {
+	if err := applyMutingRuleRRule(val.(string), map[string]interface{}{"time_zone": "UTC"}); err != nil {
+		errs = append(errs, fmt.Errorf("%#v is not a supported recurrence rule: %v", key, err))
+	}
+	return
+}
+
+// Reports whether the given arguments of two schedule blocks of a muting rule are equal,
+// ignoring unset values and the order of `weekly_repeat_days`.
+func mutingRuleScheduleConfigsEqual(a map[string]interface{}, b map[string]interface{}, attributes []string) bool {
+	var normalize func(v interface{}) string
+	normalize = func(v interface{}) string {
+		switch value := v.(type) {
+		case nil:
+			return ""
+		case *schema.Set:
+			return normalize(value.List())
+		case []string:
+			days := append([]string{}, value...)
+			sort.Strings(days)
+			return strings.Join(days, ",")
+		case []interface{}:
+			days := []string{}
+			for _, day := range value {
+				days = append(days, fmt.Sprint(day))
+			}
+			return normalize(days)
+		case int:
+			if value == 0 {
+				return ""
+			}
+		}
+		return fmt.Sprint(v)
+	}
+
+	for _, attribute := range attributes {
+		if normalize(a[attribute]) != normalize(b[attribute]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,229 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Fields populated by New Relic when a dashboard is read back or exported. They are not
+// part of the dashboard input, so they are ignored when comparing dashboard JSON documents.
+var (
+	dashboardJSONServerFields = []string{"accountId", "createdAt", "entityType", "guid", "owner", "permalink", "updatedAt"}
+	dashboardJSONPageFields   = []string{"createdAt", "guid", "owner", "updatedAt"}
+	dashboardJSONWidgetFields = []string{"id", "linkedEntities"}
+)
+
+// normalizeDashboardJSON parses a dashboard JSON document and returns it as a tree without
+// server populated fields and without null or empty values, which the API treats as unset.
+func normalizeDashboardJSON(raw string) (map[string]interface{}, error) {
+	var dashboard map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &dashboard); err != nil {
+		return nil, err
+	}
+
+	if dashboard == nil {
+		return nil, fmt.Errorf("dashboard JSON must be an object")
+	}
+
+	deleteDashboardJSONFields(dashboard, dashboardJSONServerFields)
+
+	pages, _ := dashboard["pages"].([]interface{})
+	for _, p := range pages {
+		page, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		deleteDashboardJSONFields(page, dashboardJSONPageFields)
+
+		widgets, _ := page["widgets"].([]interface{})
+		for _, w := range widgets {
+			if widget, ok := w.(map[string]interface{}); ok {
+				deleteDashboardJSONFields(widget, dashboardJSONWidgetFields)
+			}
+		}
+	}
+
+	normalized, _ := normalizeDashboardJSONValue(dashboard).(map[string]interface{})
+	if normalized == nil {
+		normalized = map[string]interface{}{}
+	}
+
+	return normalized, nil
+}
+
+func deleteDashboardJSONFields(m map[string]interface{}, fields []string) {
+	for _, f := range fields {
+		delete(m, f)
+	}
+}
+
+// normalizeDashboardJSONValue drops null values, empty strings, objects and lists, returning
+// nil when nothing is left of the value.
+func normalizeDashboardJSONValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			normalized := normalizeDashboardJSONValue(item)
+			if normalized == nil {
+				delete(value, k)
+				continue
+			}
+			value[k] = normalized
+		}
+
+		if len(value) == 0 {
+			return nil
+		}
+		return value
+	case []interface{}:
+		if len(value) == 0 {
+			return nil
+		}
+
+		// Positions are meaningful in lists, so empty elements are kept.
+		for i, item := range value {
+			value[i] = normalizeDashboardJSONValue(item)
+		}
+		return value
+	case string:
+		if value == "" {
+			return nil
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// canonicalDashboardJSON returns the normalized form of a dashboard JSON document with sorted
+// keys. Documents that cannot be parsed are returned as they are.
+func canonicalDashboardJSON(raw string) string {
+	normalized, err := normalizeDashboardJSON(raw)
+	if err != nil {
+		return raw
+	}
+
+	b, err := json.Marshal(normalized)
+	if err != nil {
+		return raw
+	}
+
+	return string(b)
+}
+
+// dashboardJSONChanges compares two dashboard JSON documents structurally and returns the
+// paths, such as pages[0].widgets[2].title, of the values that differ.
+func dashboardJSONChanges(oldJSON string, newJSON string) ([]string, error) {
+	o, err := normalizeDashboardJSON(oldJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := normalizeDashboardJSON(newJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []string{}
+	diffDashboardJSONValues("", o, n, &changes)
+
+	return changes, nil
+}
+
+func diffDashboardJSONValues(path string, o interface{}, n interface{}, changes *[]string) {
+	oMap, oIsMap := o.(map[string]interface{})
+	nMap, nIsMap := n.(map[string]interface{})
+	if oIsMap && nIsMap {
+		keys := map[string]bool{}
+		for k := range oMap {
+			keys[k] = true
+		}
+		for k := range nMap {
+			keys[k] = true
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		for _, k := range sorted {
+			keyPath := k
+			if path != "" {
+				keyPath = path + "." + k
+			}
+			diffDashboardJSONValues(keyPath, oMap[k], nMap[k], changes)
+		}
+		return
+	}
+
+	oList, oIsList := o.([]interface{})
+	nList, nIsList := n.([]interface{})
+	if oIsList && nIsList {
+		length := len(oList)
+		if len(nList) > length {
+			length = len(nList)
+		}
+
+		for i := 0; i < length; i++ {
+			var oItem, nItem interface{}
+			if i < len(oList) {
+				oItem = oList[i]
+			}
+			if i < len(nList) {
+				nItem = nList[i]
+			}
+			diffDashboardJSONValues(fmt.Sprintf("%s[%d]", path, i), oItem, nItem, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(o, n) {
+		*changes = append(*changes, path)
+	}
+}
+
+// diffSuppressDashboardJSON suppresses diffs between dashboard JSON documents that only differ
+// in formatting, key order, server populated fields or empty values.
+func diffSuppressDashboardJSON(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue == "" || newValue == "" {
+		return oldValue == newValue
+	}
+
+	changes, err := dashboardJSONChanges(oldValue, newValue)
+	if err != nil {
+		return false
+	}
+
+	return len(changes) == 0
+}
+
+// customizeDiffDashboardJSONChangedPaths shows the paths of the dashboard JSON document that
+// changed in the plan, as the diff of the `json` attribute is a diff of the whole document.
+func customizeDiffDashboardJSONChangedPaths(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("json") {
+		return d.SetNewComputed("changed_paths")
+	}
+
+	if !d.HasChange("json") {
+		return nil
+	}
+
+	oldValue, newValue := d.GetChange("json")
+
+	// The document in state is replaced when the dashboard was changed outside of Terraform
+	changes, err := dashboardJSONChanges(oldValue.(string), newValue.(string))
+	if err != nil {
+		log.Printf("[INFO] Dashboard JSON replaced: %s", err)
+		changes = []string{}
+	}
+
+	return d.SetNew("changed_paths", changes)
+}
@@ -0,0 +1,125 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+var testDashboardJSON = `{
+  "name": "Sample",
+  "permissions": "PUBLIC_READ_WRITE",
+  "pages": [
+    {
+      "name": "Overview",
+      "widgets": [
+        {
+          "title": "Transactions",
+          "layout": {"column": 1, "row": 1, "width": 4, "height": 3},
+          "visualization": {"id": "viz.line"},
+          "rawConfiguration": {
+            "nrqlQueries": [{"accountId": 1, "query": "SELECT count(*) FROM Transaction TIMESERIES"}]
+          }
+        },
+        {
+          "title": "Notes",
+          "layout": {"column": 5, "row": 1, "width": 4, "height": 3},
+          "visualization": {"id": "viz.markdown"},
+          "rawConfiguration": {"text": "# Notes"}
+        }
+      ]
+    }
+  ]
+}`
+
+func TestDashboardJSONChanges_Equivalent(t *testing.T) {
+	exported := `{"permissions":"PUBLIC_READ_WRITE","name":"Sample","description":null,"guid":"MXxWSVp8REFTSEJPQVJEfDE","variables":[],
+	  "pages":[{"guid":"MXxWSVp8REFTSEJPQVJEfDI","description":"","name":"Overview","widgets":[
+	    {"id":"1","linkedEntityGuids":null,"title":"Transactions","visualization":{"id":"viz.line"},"layout":{"height":3,"width":4,"row":1,"column":1.0},
+	     "rawConfiguration":{"nrqlQueries":[{"query":"SELECT count(*) FROM Transaction TIMESERIES","accountId":1}]}},
+	    {"id":"2","linkedEntityGuids":[],"title":"Notes","layout":{"column":5,"row":1,"width":4,"height":3},"visualization":{"id":"viz.markdown"},"rawConfiguration":{"text":"# Notes","facet":{}}}]}]}`
+
+	changes, err := dashboardJSONChanges(testDashboardJSON, exported)
+
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	require.True(t, diffSuppressDashboardJSON("json", testDashboardJSON, exported, nil))
+	require.Equal(t, canonicalDashboardJSON(testDashboardJSON), canonicalDashboardJSON(exported))
+}
+
+func TestDashboardJSONChanges_Paths(t *testing.T) {
+	changed := `{
+	  "name": "Sample",
+	  "permissions": "PUBLIC_READ_WRITE",
+	  "pages": [
+	    {
+	      "name": "Overview",
+	      "widgets": [
+	        {
+	          "title": "Transactions",
+	          "layout": {"column": 1, "row": 1, "width": 6, "height": 3},
+	          "visualization": {"id": "viz.line"},
+	          "rawConfiguration": {
+	            "nrqlQueries": [{"accountId": 1, "query": "SELECT count(*) FROM Transaction FACET appName TIMESERIES"}]
+	          }
+	        }
+	      ]
+	    },
+	    {"name": "Errors"}
+	  ]
+	}`
+
+	changes, err := dashboardJSONChanges(testDashboardJSON, changed)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"pages[0].widgets[0].layout.width",
+		"pages[0].widgets[0].rawConfiguration.nrqlQueries[0].query",
+		"pages[0].widgets[1]",
+		"pages[1]",
+	}, changes)
+	require.False(t, diffSuppressDashboardJSON("json", testDashboardJSON, changed, nil))
+}
+
+func TestDashboardJSONChanges_Invalid(t *testing.T) {
+	_, err := dashboardJSONChanges(testDashboardJSON, "The dashboard has been changed: updating")
+	require.Error(t, err)
+
+	_, err = dashboardJSONChanges(testDashboardJSON, "[]")
+	require.Error(t, err)
+
+	require.False(t, diffSuppressDashboardJSON("json", testDashboardJSON, "The dashboard has been changed: updating", nil))
+	require.False(t, diffSuppressDashboardJSON("json", "", testDashboardJSON, nil))
+	require.Equal(t, "not json", canonicalDashboardJSON("not json"))
+}
+
+func TestCustomizeDiffDashboardJSONChangedPaths(t *testing.T) {
+	r := resourceNewRelicOneDashboardJSON()
+	state := &terraform.InstanceState{
+		ID: "MXxWSVp8REFTSEJPQVJEfDE",
+		Attributes: map[string]string{
+			"id":         "MXxWSVp8REFTSEJPQVJEfDE",
+			"account_id": "1",
+			"json":       canonicalDashboardJSON(testDashboardJSON),
+		},
+	}
+
+	changed := strings.Replace(testDashboardJSON, `"# Notes"`, `"# Release notes"`, 1)
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"json": changed}), nil)
+
+	require.NoError(t, err)
+	require.Equal(t, "1", diff.Attributes["changed_paths.#"].New)
+	require.Equal(t, "pages[0].widgets[1].rawConfiguration.text", diff.Attributes["changed_paths.0"].New)
+
+	reformatted := strings.ReplaceAll(testDashboardJSON, "\n", "")
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"json": reformatted}), nil)
+
+	require.NoError(t, err)
+	require.Nil(t, diff)
+}
@@ -0,0 +1,479 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// dashboardJSONSchema describes the expected shape of a value of a dashboard JSON document.
+// It is a small subset of JSON schema, enough to validate dashboards without calling NerdGraph.
+type dashboardJSONSchema struct {
+	Type       string
+	Nullable   bool
+	Required   []string
+	Properties map[string]*dashboardJSONSchema
+	Items      *dashboardJSONSchema
+	MinItems   int
+	Enum       []string
+	Minimum    *float64
+	Maximum    *float64
+	// Properties of the rawConfiguration shared by visualizations which have no effect on this one
+	Ignored []string
+}
+
+// dashboardJSONValidationError is a validation failure located with a JSON pointer (RFC 6901).
+type dashboardJSONValidationError struct {
+	Pointer string
+	Message string
+}
+
+func (e dashboardJSONValidationError) Error() string {
+	if e.Pointer == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Message)
+}
+
+// Custom visualizations are referenced by the ID of the nerdpack providing them, followed by the
+// name of the visualization, e.g. 0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.my-visualization
+var dashboardJSONCustomVisualizationID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}\.[A-Za-z0-9_-]+$`)
+
+func dashboardJSONFloat(v float64) *float64 {
+	return &v
+}
+
+func dashboardJSONSeriesOverridesSchema(field string) *dashboardJSONSchema {
+	return &dashboardJSONSchema{
+		Type: "array",
+		Items: &dashboardJSONSchema{
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				field:        {Type: "string"},
+				"seriesName": {Type: "string"},
+			},
+		},
+	}
+}
+
+// dashboardJSONRawConfigurationProperties returns the rawConfiguration properties shared by
+// all visualizations. Visualizations may also use options that are not listed here.
+func dashboardJSONRawConfigurationProperties() map[string]*dashboardJSONSchema {
+	return map[string]*dashboardJSONSchema{
+		"nrqlQueries": {
+			Type: "array",
+			Items: &dashboardJSONSchema{
+				Type:     "object",
+				Required: []string{"query"},
+				Properties: map[string]*dashboardJSONSchema{
+					"accountId":  {Type: "integer", Minimum: dashboardJSONFloat(1)},
+					"accountIds": {Type: "array", Items: &dashboardJSONSchema{Type: "integer", Minimum: dashboardJSONFloat(1)}},
+					"query":      {Type: "string"},
+				},
+			},
+		},
+		"platformOptions": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"ignoreTimeRange": {Type: "boolean"},
+			},
+		},
+		"facet": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"showOtherSeries": {Type: "boolean"},
+			},
+		},
+		"legend": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"enabled": {Type: "boolean"},
+			},
+		},
+		"nullValues": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"nullValue":       {Type: "string", Enum: []string{"default", "preserve", "remove", "zero"}},
+				"seriesOverrides": dashboardJSONSeriesOverridesSchema("nullValue"),
+			},
+		},
+		"units": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"unit":            {Type: "string"},
+				"seriesOverrides": dashboardJSONSeriesOverridesSchema("unit"),
+			},
+		},
+		"colors": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"color":           {Type: "string"},
+				"seriesOverrides": dashboardJSONSeriesOverridesSchema("color"),
+			},
+		},
+		"yAxisLeft": {
+			Type: "object",
+			Properties: map[string]*dashboardJSONSchema{
+				"min":  {Type: "number"},
+				"max":  {Type: "number"},
+				"zero": {Type: "boolean"},
+			},
+		},
+	}
+}
+
+// dashboardJSONVisualizations lists the visualizations supported by dashboards, along with the
+// rawConfiguration properties each one requires or accepts in addition to the shared ones.
+var dashboardJSONVisualizations = map[string]*dashboardJSONSchema{
+	"logger.log-table-widget": {Required: []string{"nrqlQueries"}},
+	"viz.area":                {Required: []string{"nrqlQueries"}},
+	"viz.bar":                 {Required: []string{"nrqlQueries"}},
+	"viz.billboard": {
+		Required: []string{"nrqlQueries"},
+		Properties: map[string]*dashboardJSONSchema{
+			"thresholds": {
+				Type: "array",
+				Items: &dashboardJSONSchema{
+					Type:     "object",
+					Required: []string{"alertSeverity"},
+					Properties: map[string]*dashboardJSONSchema{
+						"alertSeverity": {Type: "string", Enum: []string{"CRITICAL", "NOT_ALERTING", "WARNING"}},
+						"value":         {Type: "number", Nullable: true},
+					},
+				},
+			},
+		},
+	},
+	"viz.bullet": {
+		Required: []string{"nrqlQueries"},
+		Properties: map[string]*dashboardJSONSchema{
+			"limit": {Type: "number"},
+		},
+	},
+	"viz.event-feed": {
+		Required: []string{"nrqlQueries"},
+		Ignored:  []string{"colors", "facet", "legend", "nullValues", "units", "yAxisLeft"},
+	},
+	"viz.funnel":    {Required: []string{"nrqlQueries"}},
+	"viz.heatmap":   {Required: []string{"nrqlQueries"}},
+	"viz.histogram": {Required: []string{"nrqlQueries"}},
+	"viz.json":      {Required: []string{"nrqlQueries"}},
+	"viz.line":      {Required: []string{"nrqlQueries"}},
+	"viz.markdown": {
+		Required: []string{"text"},
+		Properties: map[string]*dashboardJSONSchema{
+			"text": {Type: "string"},
+		},
+	},
+	"viz.pie": {Required: []string{"nrqlQueries"}},
+	"viz.scatter": {
+		Required: []string{"nrqlQueries"},
+		Properties: map[string]*dashboardJSONSchema{
+			"tooltip": {
+				Type:     "object",
+				Required: []string{"mode"},
+				Properties: map[string]*dashboardJSONSchema{
+					"mode": {Type: "string", Enum: dashboardWidgetTooltipModes},
+				},
+			},
+		},
+		Ignored: []string{"nullValues"},
+	},
+	"viz.stacked-bar": {Required: []string{"nrqlQueries"}},
+	"viz.table":       {Required: []string{"nrqlQueries"}},
+}
+
+func listValidDashboardJSONVisualizations() []string {
+	ids := make([]string, 0, len(dashboardJSONVisualizations))
+	for id := range dashboardJSONVisualizations {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// dashboardJSONDocumentSchema is the schema of a dashboard, its pages and its widgets. The
+// rawConfiguration of a widget is validated separately, based on its visualization.
+var dashboardJSONDocumentSchema = &dashboardJSONSchema{
+	Type:     "object",
+	Required: []string{"name", "pages"},
+	Properties: map[string]*dashboardJSONSchema{
+		"name":        {Type: "string"},
+		"description": {Type: "string", Nullable: true},
+		"permissions": {Type: "string", Enum: []string{"PRIVATE", "PUBLIC_READ_ONLY", "PUBLIC_READ_WRITE"}},
+		"variables":   {Type: "array", Nullable: true, Items: &dashboardJSONSchema{Type: "object", Required: []string{"name", "type"}}},
+		"pages": {
+			Type:     "array",
+			MinItems: 1,
+			Items: &dashboardJSONSchema{
+				Type:     "object",
+				Required: []string{"name"},
+				Properties: map[string]*dashboardJSONSchema{
+					"name":        {Type: "string"},
+					"description": {Type: "string", Nullable: true},
+					"guid":        {Type: "string", Nullable: true},
+					"widgets": {
+						Type:     "array",
+						Nullable: true,
+						Items: &dashboardJSONSchema{
+							Type:     "object",
+							Required: []string{"layout", "visualization"},
+							Properties: map[string]*dashboardJSONSchema{
+								"title":             {Type: "string", Nullable: true},
+								"linkedEntityGuids": {Type: "array", Nullable: true, Items: &dashboardJSONSchema{Type: "string"}},
+								"layout": {
+									Type:     "object",
+									Required: []string{"column", "row", "width", "height"},
+									Properties: map[string]*dashboardJSONSchema{
+										"column": {Type: "integer", Minimum: dashboardJSONFloat(1), Maximum: dashboardJSONFloat(12)},
+										"row":    {Type: "integer", Minimum: dashboardJSONFloat(1)},
+										"width":  {Type: "integer", Minimum: dashboardJSONFloat(1), Maximum: dashboardJSONFloat(12)},
+										"height": {Type: "integer", Minimum: dashboardJSONFloat(1)},
+									},
+								},
+								"visualization": {
+									Type:     "object",
+									Required: []string{"id"},
+									Properties: map[string]*dashboardJSONSchema{
+										"id": {Type: "string"},
+									},
+								},
+								"rawConfiguration": {Type: "object", Nullable: true},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// validateDashboardJSON validates a dashboard JSON document against the built-in schema of
+// dashboards and their visualizations, returning every failure found. Visualizations missing
+// from the built-in schema are only reported as warnings, as New Relic adds new ones over time.
+func validateDashboardJSON(raw string) ([]string, []error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(raw), &document); err != nil {
+		return nil, []error{dashboardJSONValidationError{Message: fmt.Sprintf("not a valid JSON document: %s", err)}}
+	}
+
+	var warnings []string
+	errs := validateDashboardJSONValue("", document, dashboardJSONDocumentSchema)
+
+	dashboard, _ := document.(map[string]interface{})
+	pages, _ := dashboard["pages"].([]interface{})
+	for pageIndex, p := range pages {
+		page, _ := p.(map[string]interface{})
+		widgets, _ := page["widgets"].([]interface{})
+		for widgetIndex, w := range widgets {
+			widget, ok := w.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			pointer := fmt.Sprintf("/pages/%d/widgets/%d", pageIndex, widgetIndex)
+			widgetWarnings, widgetErrs := validateDashboardJSONWidget(pointer, widget)
+			warnings = append(warnings, widgetWarnings...)
+			errs = append(errs, widgetErrs...)
+		}
+	}
+
+	return warnings, errs
+}
+
+// validateDashboardJSONWidget validates the visualization of a widget and the rawConfiguration
+// expected by that visualization. The rawConfiguration of unknown visualizations is not validated.
+func validateDashboardJSONWidget(pointer string, widget map[string]interface{}) ([]string, []error) {
+	visualization, _ := widget["visualization"].(map[string]interface{})
+	id, ok := visualization["id"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if dashboardJSONCustomVisualizationID.MatchString(id) {
+		return nil, nil
+	}
+
+	viz, ok := dashboardJSONVisualizations[id]
+	if !ok {
+		warning := dashboardJSONValidationError{
+			Pointer: pointer + "/visualization/id",
+			Message: fmt.Sprintf("unknown visualization %q, its rawConfiguration is not validated, expected one of (%s) or a custom visualization", id, strings.Join(listValidDashboardJSONVisualizations(), ", ")),
+		}
+		return []string{warning.Error()}, nil
+	}
+
+	properties := dashboardJSONRawConfigurationProperties()
+	for k, v := range viz.Properties {
+		properties[k] = v
+	}
+
+	rawConfiguration, _ := widget["rawConfiguration"].(map[string]interface{})
+
+	warnings := []string{}
+	for _, k := range viz.Ignored {
+		if _, ok := rawConfiguration[k]; ok {
+			warning := dashboardJSONValidationError{
+				Pointer: pointer + "/rawConfiguration/" + escapeDashboardJSONPointer(k),
+				Message: fmt.Sprintf("has no effect on %s widgets", id),
+			}
+			warnings = append(warnings, warning.Error())
+		}
+	}
+
+	return warnings, validateDashboardJSONValue(pointer+"/rawConfiguration", rawConfiguration, &dashboardJSONSchema{
+		Type:       "object",
+		Required:   viz.Required,
+		Properties: properties,
+	})
+}
+
+func validateDashboardJSONValue(pointer string, value interface{}, s *dashboardJSONSchema) []error {
+	fail := func(format string, a ...interface{}) []error {
+		return []error{dashboardJSONValidationError{Pointer: pointer, Message: fmt.Sprintf(format, a...)}}
+	}
+
+	if value == nil {
+		if s.Nullable {
+			return nil
+		}
+		return fail("expected %s, got null", s.Type)
+	}
+
+	switch s.Type {
+	case "object":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return fail("expected object, got %s", dashboardJSONTypeOf(value))
+		}
+
+		errs := []error{}
+		for _, k := range s.Required {
+			if v, ok := m[k]; !ok || v == nil {
+				errs = append(errs, dashboardJSONValidationError{
+					Pointer: pointer + "/" + escapeDashboardJSONPointer(k),
+					Message: "required property is missing",
+				})
+			}
+		}
+
+		keys := make([]string, 0, len(s.Properties))
+		for k := range s.Properties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v, ok := m[k]
+			if !ok || (v == nil && dashboardJSONContains(s.Required, k)) {
+				continue
+			}
+			errs = append(errs, validateDashboardJSONValue(pointer+"/"+escapeDashboardJSONPointer(k), v, s.Properties[k])...)
+		}
+
+		return errs
+	case "array":
+		l, ok := value.([]interface{})
+		if !ok {
+			return fail("expected array, got %s", dashboardJSONTypeOf(value))
+		}
+
+		if len(l) < s.MinItems {
+			return fail("expected at least %d item(s)", s.MinItems)
+		}
+
+		errs := []error{}
+		if s.Items != nil {
+			for i, item := range l {
+				errs = append(errs, validateDashboardJSONValue(fmt.Sprintf("%s/%d", pointer, i), item, s.Items)...)
+			}
+		}
+
+		return errs
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fail("expected string, got %s", dashboardJSONTypeOf(value))
+		}
+
+		if len(s.Enum) > 0 && !dashboardJSONContains(s.Enum, str) {
+			return fail("expected one of (%s), got %q", strings.Join(s.Enum, ", "), str)
+		}
+	case "number", "integer":
+		n, ok := value.(float64)
+		if !ok {
+			return fail("expected %s, got %s", s.Type, dashboardJSONTypeOf(value))
+		}
+
+		if s.Type == "integer" && n != math.Trunc(n) {
+			return fail("expected integer, got %v", n)
+		}
+
+		if s.Minimum != nil && n < *s.Minimum {
+			return fail("expected a value of at least %v, got %v", *s.Minimum, n)
+		}
+
+		if s.Maximum != nil && n > *s.Maximum {
+			return fail("expected a value of at most %v, got %v", *s.Maximum, n)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fail("expected boolean, got %s", dashboardJSONTypeOf(value))
+		}
+	}
+
+	return nil
+}
+
+func dashboardJSONTypeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func dashboardJSONContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// escapeDashboardJSONPointer escapes a property name for use in a JSON pointer (RFC 6901).
+func escapeDashboardJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// validateDashboardJSONDocument is a schema.SchemaValidateFunc validating dashboard JSON documents.
+func validateDashboardJSONDocument(val interface{}, key string) (warns []string, errs []error) {
+	warnings, validationErrs := validateDashboardJSON(val.(string))
+	for _, w := range warnings {
+		warns = append(warns, fmt.Sprintf("%s: %s", key, w))
+	}
+
+	for _, err := range validationErrs {
+		errs = append(errs, fmt.Errorf("%s: %s", key, err))
+	}
+
+	return warns, errs
+}
@@ -0,0 +1,118 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDashboardJSON_Valid(t *testing.T) {
+	warnings, errs := validateDashboardJSON(testDashboardJSON)
+	require.Empty(t, warnings)
+	require.Empty(t, errs)
+
+	custom := `{"name":"Custom","pages":[{"name":"Page","widgets":[
+	  {"layout":{"column":1,"row":1,"width":4,"height":3},"visualization":{"id":"0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.my-visualization"},"rawConfiguration":{"anything":true}},
+	  {"layout":{"column":5,"row":1,"width":4,"height":3},"visualization":{"id":"viz.billboard"},"linkedEntityGuids":null,
+	   "rawConfiguration":{"nrqlQueries":[{"accountIds":[1],"query":"SELECT count(*) FROM Transaction"}],"thresholds":[{"alertSeverity":"WARNING","value":10},{"alertSeverity":"CRITICAL","value":null}],"dataFormatters":[]}}]},
+	  {"name":"Empty","widgets":[]}]}`
+	warnings, errs = validateDashboardJSON(custom)
+	require.Empty(t, warnings)
+	require.Empty(t, errs)
+}
+
+func TestValidateDashboardJSON_UnknownVisualization(t *testing.T) {
+	unknown := `{"name":"Sample","pages":[{"name":"Page","widgets":[
+	  {"layout":{"column":1,"row":1,"width":4,"height":3},"visualization":{"id":"viz.service-map"},"rawConfiguration":{"anything":true}}]}]}`
+
+	warnings, errs := validateDashboardJSON(unknown)
+	require.Empty(t, errs)
+	require.Equal(t, []string{"/pages/0/widgets/0/visualization/id: unknown visualization \"viz.service-map\", its rawConfiguration is not validated, expected one of (logger.log-table-widget, viz.area, viz.bar, viz.billboard, viz.bullet, viz.event-feed, viz.funnel, viz.heatmap, viz.histogram, viz.json, viz.line, viz.markdown, viz.pie, viz.scatter, viz.stacked-bar, viz.table) or a custom visualization"}, warnings)
+
+	warns, validationErrs := validateDashboardJSONDocument(unknown, "json")
+	require.Empty(t, validationErrs)
+	require.Len(t, warns, 1)
+}
+
+func TestValidateDashboardJSON_VisualizationOptions(t *testing.T) {
+	document := `{"name":"Sample","pages":[{"name":"Page","widgets":[
+	  {"layout":{"column":1,"row":1,"width":4,"height":3},"visualization":{"id":"viz.event-feed"},
+	   "rawConfiguration":{"nrqlQueries":[{"accountId":1,"query":"FROM Deployment SELECT *"}],"legend":{"enabled":true}}},
+	  {"layout":{"column":5,"row":1,"width":4,"height":3},"visualization":{"id":"viz.scatter"},
+	   "rawConfiguration":{"nrqlQueries":[{"accountId":1,"query":"FROM Transaction SELECT duration"}],"yAxisLeft":{"zero":true},"tooltip":{"mode":"every"}}}]}]}`
+
+	warnings, errs := validateDashboardJSON(document)
+	require.Equal(t, []string{"/pages/0/widgets/0/rawConfiguration/legend: has no effect on viz.event-feed widgets"}, warnings)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), "/pages/0/widgets/1/rawConfiguration/tooltip/mode")
+}
+
+func TestValidateDashboardJSON_Invalid(t *testing.T) {
+	cases := map[string]struct {
+		JSON     string
+		Expected []string
+	}{
+		"not json": {
+			JSON:     `{"name":`,
+			Expected: []string{"not a valid JSON document: unexpected end of JSON input"},
+		},
+		"not an object": {
+			JSON:     `[]`,
+			Expected: []string{"expected object, got array"},
+		},
+		"missing pages": {
+			JSON:     `{"name":"Sample","permissions":"PUBLIC"}`,
+			Expected: []string{"/pages: required property is missing", "/permissions: expected one of (PRIVATE, PUBLIC_READ_ONLY, PUBLIC_READ_WRITE), got \"PUBLIC\""},
+		},
+		"missing layout": {
+			JSON: `{"name":"Sample","pages":[{"name":"Page","widgets":[
+			  {"visualization":{"id":"viz.markdown"},"rawConfiguration":{"text":"# Title"}},
+			  {"layout":{"column":13,"row":1,"width":4.5},"visualization":{"id":"viz.markdown"},"rawConfiguration":{"text":"# Title"}}]}]}`,
+			Expected: []string{
+				"/pages/0/widgets/0/layout: required property is missing",
+				"/pages/0/widgets/1/layout/height: required property is missing",
+				"/pages/0/widgets/1/layout/column: expected a value of at most 12, got 13",
+				"/pages/0/widgets/1/layout/width: expected integer, got 4.5",
+			},
+		},
+		"malformed billboard thresholds": {
+			JSON: `{"name":"Sample","pages":[{"name":"Page","widgets":[
+			  {"layout":{"column":1,"row":1,"width":4,"height":3},"visualization":{"id":"viz.billboard"},
+			   "rawConfiguration":{"nrqlQueries":[{"accountId":1,"query":"SELECT count(*) FROM Transaction"}],"thresholds":[{"alertSeverity":"ERROR","value":"10"}]}}]}]}`,
+			Expected: []string{
+				"/pages/0/widgets/0/rawConfiguration/thresholds/0/alertSeverity: expected one of (CRITICAL, NOT_ALERTING, WARNING), got \"ERROR\"",
+				"/pages/0/widgets/0/rawConfiguration/thresholds/0/value: expected number, got string",
+			},
+		},
+		"malformed line units": {
+			JSON: `{"name":"Sample","pages":[{"name":"Page","widgets":[
+			  {"layout":{"column":1,"row":1,"width":4,"height":3},"visualization":{"id":"viz.line"},
+			   "rawConfiguration":{"nrqlQueries":[{"accountId":1,"query":"SELECT count(*) FROM Transaction"}],"units":[{"unit":"ms"}]}}]}]}`,
+			Expected: []string{"/pages/0/widgets/0/rawConfiguration/units: expected object, got array"},
+		},
+		"missing queries": {
+			JSON: `{"name":"Sample","pages":[{"name":"Page","widgets":[
+			  {"layout":{"column":1,"row":1,"width":4,"height":3},"visualization":{"id":"viz.table"}}]}]}`,
+			Expected: []string{"/pages/0/widgets/0/rawConfiguration/nrqlQueries: required property is missing"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateDashboardJSON(tc.JSON)
+
+			messages := make([]string, len(errs))
+			for i, err := range errs {
+				messages[i] = err.Error()
+			}
+			require.Equal(t, tc.Expected, messages)
+		})
+	}
+}
+
+func TestEscapeDashboardJSONPointer(t *testing.T) {
+	require.Equal(t, "a~1b~0c", escapeDashboardJSONPointer("a/b~c"))
+}
@@ -0,0 +1,377 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+)
+
+// Dashboard pages are laid out on a grid of 12 columns and as many rows as needed.
+const dashboardGridColumns = 12
+
+// The widget blocks of a newrelic_one_dashboard page, in the order they are expanded.
+var dashboardWidgetTypes = []string{
+	"widget_area",
+	"widget_bar",
+	"widget_billboard",
+	"widget_bullet",
+	"widget_funnel",
+	"widget_heatmap",
+	"widget_histogram",
+	"widget_line",
+	"widget_markdown",
+	"widget_pie",
+	"widget_table",
+	"widget_log_table",
+	"widget_json",
+	"widget_stacked_bar",
+	"widget_event_feed",
+	"widget_scatter",
+}
+
+// dashboardWidgetPlacement is the position of a widget on the grid of a dashboard page.
+type dashboardWidgetPlacement struct {
+	Name   string
+	Row    int
+	Column int
+	Width  int
+	Height int
+}
+
+func (p dashboardWidgetPlacement) lastColumn() int {
+	return p.Column + p.Width - 1
+}
+
+func (p dashboardWidgetPlacement) lastRow() int {
+	return p.Row + p.Height - 1
+}
+
+func (p dashboardWidgetPlacement) overlaps(o dashboardWidgetPlacement) bool {
+	return p.Column <= o.lastColumn() && o.Column <= p.lastColumn() && p.Row <= o.lastRow() && o.Row <= p.lastRow()
+}
+
+// lintDashboardWidgetPlacements returns an error for each widget placed outside of the grid and
+// for each pair of overlapping widgets.
+func lintDashboardWidgetPlacements(page string, placements []dashboardWidgetPlacement) []error {
+	errs := []error{}
+
+	for i, p := range placements {
+		switch {
+		case p.Column < 1 || p.Row < 1:
+			errs = append(errs, fmt.Errorf("page %q: %s is placed at row %d, column %d, rows and columns start at 1", page, p.Name, p.Row, p.Column))
+		case p.lastColumn() > dashboardGridColumns:
+			errs = append(errs, fmt.Errorf("page %q: %s spans columns %d to %d, past the %d columns of the grid", page, p.Name, p.Column, p.lastColumn(), dashboardGridColumns))
+		}
+
+		for _, o := range placements[i+1:] {
+			if p.overlaps(o) {
+				errs = append(errs, fmt.Errorf("page %q: %s (row %d, column %d) overlaps %s (row %d, column %d)", page, p.Name, p.Row, p.Column, o.Name, o.Row, o.Column))
+			}
+		}
+	}
+
+	return errs
+}
+
+// placeDashboardWidgets places the widgets without a row or column in the first free space of
+// the grid, reading row by row, keeping the order of the widgets.
+func placeDashboardWidgets(widgets []dashboards.DashboardWidgetInput) error {
+	occupied := map[[2]int]bool{}
+	occupy := func(l dashboards.DashboardWidgetLayoutInput) {
+		for r := l.Row; r < l.Row+l.Height; r++ {
+			for c := l.Column; c < l.Column+l.Width; c++ {
+				occupied[[2]int{r, c}] = true
+			}
+		}
+	}
+	free := func(row int, column int, l dashboards.DashboardWidgetLayoutInput) bool {
+		for r := row; r < row+l.Height; r++ {
+			for c := column; c < column+l.Width; c++ {
+				if occupied[[2]int{r, c}] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	for _, w := range widgets {
+		if w.Layout.Row > 0 && w.Layout.Column > 0 {
+			occupy(w.Layout)
+		}
+	}
+
+	row, column := 1, 1
+	for i := range widgets {
+		l := &widgets[i].Layout
+		if l.Row > 0 && l.Column > 0 {
+			continue
+		}
+
+		if l.Width < 1 || l.Width > dashboardGridColumns || l.Height < 1 {
+			return fmt.Errorf("widget %q can't be placed automatically with a width of %d and a height of %d", widgets[i].Title, l.Width, l.Height)
+		}
+
+		for !(column+l.Width-1 <= dashboardGridColumns && free(row, column, *l)) {
+			column++
+			if column+l.Width-1 > dashboardGridColumns {
+				row, column = row+1, 1
+			}
+		}
+
+		l.Row, l.Column = row, column
+		occupy(*l)
+		column += l.Width
+	}
+
+	return nil
+}
+
+// dashboardWidgetPlacementKeys returns the keys of the widgets of a page in widget_placements, by
+// widget type, in the order of the widgets of each type. A widget is identified by the index of
+// its page, its type and its title, followed by its rank when several widgets share them.
+func dashboardWidgetPlacementKeys(pageIndex int, page map[string]interface{}) map[string][]string {
+	keys := map[string][]string{}
+	seen := map[string]int{}
+
+	for _, widgetType := range dashboardWidgetTypes {
+		widgets, _ := page[widgetType].([]interface{})
+		for _, w := range widgets {
+			widget, _ := w.(map[string]interface{})
+			title, _ := widget["title"].(string)
+
+			key := fmt.Sprintf("%d/%s/%s", pageIndex, widgetType, title)
+			seen[key]++
+			if seen[key] > 1 {
+				key = fmt.Sprintf("%s/%d", key, seen[key])
+			}
+
+			keys[widgetType] = append(keys[widgetType], key)
+		}
+	}
+
+	return keys
+}
+
+func formatDashboardWidgetPlacement(row int, column int) string {
+	return fmt.Sprintf("row %d, column %d", row, column)
+}
+
+func parseDashboardWidgetPlacement(v interface{}) (int, int, bool) {
+	var row, column int
+
+	s, _ := v.(string)
+	if _, err := fmt.Sscanf(s, "row %d, column %d", &row, &column); err != nil {
+		return 0, 0, false
+	}
+
+	return row, column, true
+}
+
+// dashboardStoredWidgetPlacements returns the widget placements stored in state, completed with
+// the planned ones when applying.
+func dashboardStoredWidgetPlacements(d interface {
+	GetChange(string) (interface{}, interface{})
+}) map[string]interface{} {
+	stored := map[string]interface{}{}
+
+	o, n := d.GetChange("widget_placements")
+	for _, placements := range []interface{}{o, n} {
+		m, _ := placements.(map[string]interface{})
+		for k, v := range m {
+			stored[k] = v
+		}
+	}
+
+	return stored
+}
+
+// placeDashboardPageWidgets places the widgets of a page with auto_layout that have no row and
+// column. A widget keeps the position stored for it in widget_placements while it is still free,
+// the others are placed in the first free space of the grid. keys holds the key of each widget.
+func placeDashboardPageWidgets(widgets []dashboards.DashboardWidgetInput, keys []string, stored map[string]interface{}) error {
+	occupied := []dashboardWidgetPlacement{}
+	for _, w := range widgets {
+		if w.Layout.Row > 0 && w.Layout.Column > 0 {
+			occupied = append(occupied, dashboardWidgetPlacement{Row: w.Layout.Row, Column: w.Layout.Column, Width: w.Layout.Width, Height: w.Layout.Height})
+		}
+	}
+
+	for i := range widgets {
+		l := &widgets[i].Layout
+		if l.Row > 0 && l.Column > 0 || i >= len(keys) {
+			continue
+		}
+
+		row, column, ok := parseDashboardWidgetPlacement(stored[keys[i]])
+		if !ok {
+			continue
+		}
+
+		p := dashboardWidgetPlacement{Row: row, Column: column, Width: l.Width, Height: l.Height}
+		if row < 1 || column < 1 || p.lastColumn() > dashboardGridColumns || dashboardWidgetPlacementOverlaps(p, occupied) {
+			continue
+		}
+
+		l.Row, l.Column = row, column
+		occupied = append(occupied, p)
+	}
+
+	return placeDashboardWidgets(widgets)
+}
+
+func dashboardWidgetPlacementOverlaps(p dashboardWidgetPlacement, placements []dashboardWidgetPlacement) bool {
+	for _, o := range placements {
+		if p.overlaps(o) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// customizeDiffDashboardLayout checks the placement of the widgets of the pages of a dashboard
+// when they change. Widgets of pages with auto_layout may leave out their row and column, they
+// are placed in the plan and their placement is stored in widget_placements.
+// nolint:gocyclo
+func customizeDiffDashboardLayout(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("page") {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return d.SetNewComputed("widget_placements")
+	}
+
+	pagesConfig := config.GetAttr("page")
+	if !pagesConfig.IsKnown() || pagesConfig.IsNull() {
+		return d.SetNewComputed("widget_placements")
+	}
+
+	errs := []string{}
+	known := true
+	stored := dashboardStoredWidgetPlacements(d)
+	widgetPlacements := map[string]interface{}{}
+	pages := d.Get("page").([]interface{})
+
+	for pageIndex, pageConfig := range pagesConfig.AsValueSlice() {
+		if pageIndex >= len(pages) || !pageConfig.IsKnown() || pageConfig.IsNull() {
+			known = false
+			continue
+		}
+
+		page := pages[pageIndex].(map[string]interface{})
+		pageName := page["name"].(string)
+		autoLayout, _ := page["auto_layout"].(bool)
+		pageKeys := dashboardWidgetPlacementKeys(pageIndex, page)
+		widgets := []dashboards.DashboardWidgetInput{}
+		keys := []string{}
+
+		for _, widgetType := range dashboardWidgetTypes {
+			widgetsConfig := pageConfig.GetAttr(widgetType)
+			pageWidgets, _ := page[widgetType].([]interface{})
+			if !widgetsConfig.IsKnown() {
+				known = false
+				continue
+			}
+			if widgetsConfig.IsNull() {
+				continue
+			}
+
+			for widgetIndex, widgetConfig := range widgetsConfig.AsValueSlice() {
+				if widgetIndex >= len(pageWidgets) || !widgetConfig.IsKnown() || widgetConfig.IsNull() {
+					known = false
+					continue
+				}
+
+				w := pageWidgets[widgetIndex].(map[string]interface{})
+				name := fmt.Sprintf("%s %q", widgetType, w["title"])
+				rowConfig, columnConfig := widgetConfig.GetAttr("row"), widgetConfig.GetAttr("column")
+
+				if rowConfig.IsNull() != columnConfig.IsNull() {
+					errs = append(errs, fmt.Sprintf("page %q: %s must set both row and column, or neither to be placed automatically", pageName, name))
+					continue
+				}
+
+				if rowConfig.IsNull() && !autoLayout {
+					errs = append(errs, fmt.Sprintf("page %q: %s must set row and column unless auto_layout is enabled on the page", pageName, name))
+					continue
+				}
+
+				if !dashboardLayoutConfigKnown(widgetConfig) {
+					known = false
+					continue
+				}
+
+				// The row and column of the widgets placed automatically are zero until placed
+				widgets = append(widgets, dashboards.DashboardWidgetInput{
+					Title: name,
+					Layout: dashboards.DashboardWidgetLayoutInput{
+						Row:    w["row"].(int),
+						Column: w["column"].(int),
+						Width:  w["width"].(int),
+						Height: w["height"].(int),
+					},
+				})
+				keys = append(keys, pageKeys[widgetType][widgetIndex])
+			}
+		}
+
+		if autoLayout {
+			auto := make([]bool, len(widgets))
+			for i, w := range widgets {
+				auto[i] = w.Layout.Row == 0 && w.Layout.Column == 0
+			}
+
+			if err := placeDashboardPageWidgets(widgets, keys, stored); err != nil {
+				errs = append(errs, fmt.Sprintf("page %q: %s", pageName, err))
+				continue
+			}
+
+			for i, w := range widgets {
+				if auto[i] {
+					widgetPlacements[keys[i]] = formatDashboardWidgetPlacement(w.Layout.Row, w.Layout.Column)
+				}
+			}
+		}
+
+		placements := make([]dashboardWidgetPlacement, len(widgets))
+		for i, w := range widgets {
+			placements[i] = dashboardWidgetPlacement{Name: w.Title, Row: w.Layout.Row, Column: w.Layout.Column, Width: w.Layout.Width, Height: w.Layout.Height}
+		}
+
+		for _, err := range lintDashboardWidgetPlacements(pageName, placements) {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid widget layout:\n%s", strings.Join(errs, "\n"))
+	}
+
+	if !known {
+		return d.SetNewComputed("widget_placements")
+	}
+
+	if o, _ := d.GetChange("widget_placements"); d.Id() == "" || !reflect.DeepEqual(o, widgetPlacements) {
+		return d.SetNew("widget_placements", widgetPlacements)
+	}
+
+	return nil
+}
+
+func dashboardLayoutConfigKnown(widgetConfig cty.Value) bool {
+	for _, attr := range []string{"row", "column", "width", "height"} {
+		if !widgetConfig.GetAttr(attr).IsKnown() {
+			return false
+		}
+	}
+
+	return true
+}
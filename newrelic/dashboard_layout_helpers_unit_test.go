@@ -0,0 +1,201 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintDashboardWidgetPlacements(t *testing.T) {
+	placements := []dashboardWidgetPlacement{
+		{Name: `widget_line "Throughput"`, Row: 1, Column: 1, Width: 6, Height: 3},
+		{Name: `widget_bar "Errors"`, Row: 3, Column: 4, Width: 4, Height: 3},
+		{Name: `widget_table "Hosts"`, Row: 4, Column: 10, Width: 4, Height: 3},
+		{Name: `widget_markdown "Notes"`, Row: 0, Column: 9, Width: 1, Height: 1},
+	}
+
+	errs := lintDashboardWidgetPlacements("Overview", placements)
+
+	require.Len(t, errs, 3)
+	require.EqualError(t, errs[0], `page "Overview": widget_line "Throughput" (row 1, column 1) overlaps widget_bar "Errors" (row 3, column 4)`)
+	require.EqualError(t, errs[1], `page "Overview": widget_table "Hosts" spans columns 10 to 13, past the 12 columns of the grid`)
+	require.EqualError(t, errs[2], `page "Overview": widget_markdown "Notes" is placed at row 0, column 9, rows and columns start at 1`)
+}
+
+func TestLintDashboardWidgetPlacements_Valid(t *testing.T) {
+	placements := []dashboardWidgetPlacement{
+		{Name: "a", Row: 1, Column: 1, Width: 4, Height: 3},
+		{Name: "b", Row: 1, Column: 5, Width: 8, Height: 3},
+		{Name: "c", Row: 4, Column: 1, Width: 12, Height: 2},
+	}
+
+	require.Empty(t, lintDashboardWidgetPlacements("Overview", placements))
+}
+
+func TestPlaceDashboardWidgets(t *testing.T) {
+	widgets := []dashboards.DashboardWidgetInput{
+		{Title: "a", Layout: dashboards.DashboardWidgetLayoutInput{Width: 4, Height: 3}},
+		{Title: "fixed", Layout: dashboards.DashboardWidgetLayoutInput{Row: 1, Column: 5, Width: 4, Height: 6}},
+		{Title: "b", Layout: dashboards.DashboardWidgetLayoutInput{Width: 4, Height: 3}},
+		{Title: "c", Layout: dashboards.DashboardWidgetLayoutInput{Width: 6, Height: 3}},
+		{Title: "d", Layout: dashboards.DashboardWidgetLayoutInput{Width: 12, Height: 3}},
+	}
+
+	require.NoError(t, placeDashboardWidgets(widgets))
+
+	placed := map[string][2]int{}
+	for _, w := range widgets {
+		placed[w.Title] = [2]int{w.Layout.Row, w.Layout.Column}
+	}
+
+	require.Equal(t, map[string][2]int{
+		"a":     {1, 1},
+		"fixed": {1, 5},
+		"b":     {1, 9},
+		"c":     {7, 1},
+		"d":     {10, 1},
+	}, placed)
+}
+
+func TestPlaceDashboardWidgets_InvalidSize(t *testing.T) {
+	widgets := []dashboards.DashboardWidgetInput{
+		{Title: "wide", Layout: dashboards.DashboardWidgetLayoutInput{Width: 13, Height: 3}},
+	}
+
+	require.EqualError(t, placeDashboardWidgets(widgets), `widget "wide" can't be placed automatically with a width of 13 and a height of 3`)
+}
+
+func testDashboardMarkdownWidgetConfig(title string, width int) map[string]interface{} {
+	return map[string]interface{}{"title": title, "text": "# " + title, "width": width}
+}
+
+// testDashboardRawConfig returns the raw configuration read by customizeDiffDashboardLayout, it is
+// passed to the diff along with the state.
+func testDashboardRawConfig(t *testing.T, r *schema.Resource, raw map[string]interface{}) cty.Value {
+	b, err := json.Marshal(raw)
+	require.NoError(t, err)
+
+	v, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err)
+
+	return v
+}
+
+func TestCustomizeDiffDashboardLayout_InsertWidget(t *testing.T) {
+	r := resourceNewRelicOneDashboard()
+
+	// The widgets placed automatically keep no row and column, their placement is stored apart
+	state := &terraform.InstanceState{
+		ID: "MXxWSVp8REFTSEJPQVJEfDE",
+		Attributes: map[string]string{
+			"id":                                             "MXxWSVp8REFTSEJPQVJEfDE",
+			"name":                                           "Sample",
+			"permissions":                                    "public_read_only",
+			"page.#":                                         "1",
+			"page.0.name":                                    "Overview",
+			"page.0.auto_layout":                             "true",
+			"page.0.widget_markdown.#":                       "2",
+			"page.0.widget_markdown.0.title":                 "Throughput",
+			"page.0.widget_markdown.0.text":                  "# Throughput",
+			"page.0.widget_markdown.0.row":                   "0",
+			"page.0.widget_markdown.0.column":                "0",
+			"page.0.widget_markdown.0.width":                 "4",
+			"page.0.widget_markdown.0.height":                "3",
+			"page.0.widget_markdown.1.title":                 "Errors",
+			"page.0.widget_markdown.1.text":                  "# Errors",
+			"page.0.widget_markdown.1.row":                   "0",
+			"page.0.widget_markdown.1.column":                "0",
+			"page.0.widget_markdown.1.width":                 "8",
+			"page.0.widget_markdown.1.height":                "3",
+			"widget_placements.%":                            "2",
+			"widget_placements.0/widget_markdown/Throughput": "row 1, column 1",
+			"widget_placements.0/widget_markdown/Errors":     "row 1, column 5",
+		},
+	}
+
+	config := map[string]interface{}{
+		"name": "Sample",
+		"page": []interface{}{
+			map[string]interface{}{
+				"name":        "Overview",
+				"auto_layout": true,
+				"widget_markdown": []interface{}{
+					testDashboardMarkdownWidgetConfig("Latency", 8),
+					testDashboardMarkdownWidgetConfig("Throughput", 4),
+					testDashboardMarkdownWidgetConfig("Errors", 8),
+				},
+			},
+		},
+	}
+
+	// The inserted widget is placed in the first free space, the others keep their placement
+	state.RawConfig = testDashboardRawConfig(t, r, config)
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		"widget_placements.%":                            "3",
+		"widget_placements.0/widget_markdown/Latency":    "row 4, column 1",
+		"widget_placements.0/widget_markdown/Throughput": "row 1, column 1",
+		"widget_placements.0/widget_markdown/Errors":     "row 1, column 5",
+	}
+	for k, v := range expected {
+		if attr := diff.Attributes[k]; attr != nil {
+			require.Equal(t, v, attr.New, k)
+		} else {
+			require.Equal(t, v, state.Attributes[k], k)
+		}
+	}
+	require.NotNil(t, diff.Attributes["widget_placements.0/widget_markdown/Latency"])
+}
+
+func TestFlattenDashboardPageAutoLayout(t *testing.T) {
+	r := resourceNewRelicOneDashboard()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "Sample",
+		"page": []interface{}{
+			map[string]interface{}{
+				"name":        "Overview",
+				"auto_layout": true,
+				"widget_markdown": []interface{}{
+					testDashboardMarkdownWidgetConfig("Throughput", 4),
+					testDashboardMarkdownWidgetConfig("Errors", 8),
+				},
+			},
+		},
+	})
+	require.NoError(t, d.Set("widget_placements", map[string]interface{}{
+		"0/widget_markdown/Throughput": "row 1, column 1",
+		"0/widget_markdown/Errors":     "row 1, column 5",
+	}))
+
+	// Errors was moved outside of Terraform
+	pages := []interface{}{
+		map[string]interface{}{
+			"name": "Overview",
+			"widget_markdown": []interface{}{
+				map[string]interface{}{"title": "Throughput", "row": 1, "column": 1, "width": 4, "height": 3},
+				map[string]interface{}{"title": "Errors", "row": 4, "column": 1, "width": 8, "height": 3},
+			},
+		},
+	}
+
+	flattenDashboardPageAutoLayout(pages, d)
+
+	widgets := pages[0].(map[string]interface{})["widget_markdown"].([]interface{})
+	require.Equal(t, 0, widgets[0].(map[string]interface{})["row"])
+	require.Equal(t, 0, widgets[0].(map[string]interface{})["column"])
+	require.Equal(t, 4, widgets[1].(map[string]interface{})["row"])
+	require.Equal(t, 1, widgets[1].(map[string]interface{})["column"])
+	require.Equal(t, "row 1, column 5", d.Get("widget_placements.0/widget_markdown/Errors"))
+}
@@ -0,0 +1,169 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	nr "github.com/newrelic/newrelic-client-go/v2/newrelic"
+)
+
+const (
+	dashboardLiveURLTypeDashboard = "DASHBOARD"
+	dashboardLiveURLTypeWidget    = "WIDGET"
+)
+
+const dashboardCreateLiveURLMutation = `
+	mutation($guid: EntityGuid!, $widgetId: ID) {
+		dashboardCreateLiveUrl(entityGuid: $guid, widgetId: $widgetId) {
+			liveUrl {
+				createdAt
+				title
+				type
+				url
+				uuid
+			}
+			errors {
+				description
+				type
+			}
+		}
+	}`
+
+const dashboardLiveURLsQuery = `
+	query($type: DashboardLiveUrlType) {
+		actor {
+			dashboard {
+				liveUrls(filter: {type: $type}) {
+					liveUrls {
+						createdAt
+						title
+						type
+						url
+						uuid
+					}
+				}
+			}
+		}
+	}`
+
+const dashboardRevokeLiveURLMutation = `
+	mutation($uuid: ID!) {
+		dashboardRevokeLiveUrl(uuid: $uuid) {
+			uuid
+			errors {
+				description
+				type
+			}
+		}
+	}`
+
+type dashboardLiveURL struct {
+	CreatedAt int64  `json:"createdAt"`
+	Title     string `json:"title"`
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	UUID      string `json:"uuid"`
+}
+
+type dashboardLiveURLError struct {
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+type dashboardCreateLiveURLResponse struct {
+	DashboardCreateLiveURL struct {
+		LiveURL *dashboardLiveURL       `json:"liveUrl"`
+		Errors  []dashboardLiveURLError `json:"errors"`
+	} `json:"dashboardCreateLiveUrl"`
+}
+
+type dashboardLiveURLsResponse struct {
+	Actor struct {
+		Dashboard struct {
+			LiveURLs struct {
+				LiveURLs []dashboardLiveURL `json:"liveUrls"`
+			} `json:"liveUrls"`
+		} `json:"dashboard"`
+	} `json:"actor"`
+}
+
+type dashboardRevokeLiveURLResponse struct {
+	DashboardRevokeLiveURL struct {
+		UUID   string                  `json:"uuid"`
+		Errors []dashboardLiveURLError `json:"errors"`
+	} `json:"dashboardRevokeLiveUrl"`
+}
+
+// Joins the errors returned by the live URL mutations into a single error.
+func dashboardLiveURLErrors(action string, errs []dashboardLiveURLError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = fmt.Sprintf("%s: %s", e.Type, e.Description)
+	}
+
+	return fmt.Errorf("error %s live URL: %s", action, strings.Join(messages, ", "))
+}
+
+// Creates a live URL for a dashboard, or for a widget of a dashboard page when widgetID is set.
+func createDashboardLiveURL(ctx context.Context, client *nr.NewRelic, guid string, widgetID string) (*dashboardLiveURL, error) {
+	vars := map[string]interface{}{
+		"guid": guid,
+	}
+	if widgetID != "" {
+		vars["widgetId"] = widgetID
+	}
+
+	resp := dashboardCreateLiveURLResponse{}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, dashboardCreateLiveURLMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if err := dashboardLiveURLErrors("creating", resp.DashboardCreateLiveURL.Errors); err != nil {
+		return nil, err
+	}
+
+	if resp.DashboardCreateLiveURL.LiveURL == nil {
+		return nil, fmt.Errorf("error creating live URL for %s: response was nil", guid)
+	}
+
+	return resp.DashboardCreateLiveURL.LiveURL, nil
+}
+
+// Finds a live URL by its UUID, returning nil when it doesn't exist anymore.
+func getDashboardLiveURL(ctx context.Context, client *nr.NewRelic, liveURLType string, uuid string) (*dashboardLiveURL, error) {
+	vars := map[string]interface{}{
+		"type": liveURLType,
+	}
+
+	resp := dashboardLiveURLsResponse{}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, dashboardLiveURLsQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	for _, liveURL := range resp.Actor.Dashboard.LiveURLs.LiveURLs {
+		if liveURL.UUID == uuid {
+			l := liveURL
+			return &l, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func revokeDashboardLiveURL(ctx context.Context, client *nr.NewRelic, uuid string) error {
+	vars := map[string]interface{}{
+		"uuid": uuid,
+	}
+
+	resp := dashboardRevokeLiveURLResponse{}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, dashboardRevokeLiveURLMutation, vars, &resp); err != nil {
+		return err
+	}
+
+	return dashboardLiveURLErrors("revoking", resp.DashboardRevokeLiveURL.Errors)
+}
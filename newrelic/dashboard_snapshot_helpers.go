@@ -0,0 +1,126 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	nr "github.com/newrelic/newrelic-client-go/v2/newrelic"
+)
+
+const dashboardCreateSnapshotURLMutation = `
+	mutation($guid: EntityGuid!, $params: DashboardSnapshotUrlInput) {
+		dashboardCreateSnapshotUrl(guid: $guid, params: $params)
+	}`
+
+var dashboardSnapshotFormats = []string{"PDF", "PNG"}
+
+type dashboardCreateSnapshotURLResponse struct {
+	DashboardCreateSnapshotURL string `json:"dashboardCreateSnapshotUrl"`
+}
+
+// expandDashboardSnapshotTimeWindow builds the time window of a snapshot, in milliseconds as
+// expected by NerdGraph. A time window needs two of begin_time, end_time and duration.
+func expandDashboardSnapshotTimeWindow(in []interface{}) (map[string]interface{}, error) {
+	if len(in) == 0 || in[0] == nil {
+		return nil, nil
+	}
+
+	cfg := in[0].(map[string]interface{})
+	timeWindow := map[string]interface{}{}
+
+	for key, param := range map[string]string{"begin_time": "beginTime", "end_time": "endTime"} {
+		if v, ok := cfg[key]; ok && v.(string) != "" {
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %s", key, err)
+			}
+			timeWindow[param] = t.UnixMilli()
+		}
+	}
+
+	if v, ok := cfg["duration"]; ok && v.(string) != "" {
+		duration, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %s", err)
+		}
+		timeWindow["duration"] = duration.Milliseconds()
+	}
+
+	if _, ok := timeWindow["duration"]; !ok && len(timeWindow) < 2 {
+		return nil, fmt.Errorf("a time window needs a duration, or both a begin_time and an end_time")
+	}
+
+	return timeWindow, nil
+}
+
+// Creates a snapshot of a dashboard or a dashboard page, returning the URL of the PDF.
+func createDashboardSnapshotURL(ctx context.Context, client *nr.NewRelic, guid string, timeWindow map[string]interface{}) (string, error) {
+	vars := map[string]interface{}{
+		"guid": guid,
+	}
+	if timeWindow != nil {
+		vars["params"] = map[string]interface{}{
+			"timeWindow": timeWindow,
+		}
+	}
+
+	resp := dashboardCreateSnapshotURLResponse{}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, dashboardCreateSnapshotURLMutation, vars, &resp); err != nil {
+		return "", err
+	}
+
+	if resp.DashboardCreateSnapshotURL == "" {
+		return "", fmt.Errorf("error creating a snapshot of dashboard %s: response was empty", guid)
+	}
+
+	return resp.DashboardCreateSnapshotURL, nil
+}
+
+// Snapshot URLs render a PDF, the format query parameter selects another format.
+func dashboardSnapshotURLWithFormat(snapshotURL string, format string) (string, error) {
+	u, err := url.Parse(snapshotURL)
+	if err != nil {
+		return "", err
+	}
+
+	q := u.Query()
+	q.Set("format", format)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Downloads a snapshot to a local file.
+func writeDashboardSnapshot(ctx context.Context, snapshotURL string, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, snapshotURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error downloading dashboard snapshot: %s", resp.Status)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,61 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandDashboardSnapshotTimeWindow(t *testing.T) {
+	timeWindow, err := expandDashboardSnapshotTimeWindow([]interface{}{
+		map[string]interface{}{"begin_time": "2026-10-12T00:00:00Z", "end_time": "", "duration": "168h"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"beginTime": int64(1791763200000),
+		"duration":  int64(604800000),
+	}, timeWindow)
+
+	timeWindow, err = expandDashboardSnapshotTimeWindow(nil)
+	require.NoError(t, err)
+	require.Nil(t, timeWindow)
+
+	_, err = expandDashboardSnapshotTimeWindow([]interface{}{
+		map[string]interface{}{"begin_time": "2026-10-12T00:00:00Z", "end_time": "", "duration": ""},
+	})
+	require.EqualError(t, err, "a time window needs a duration, or both a begin_time and an end_time")
+}
+
+func TestDashboardSnapshotURLWithFormat(t *testing.T) {
+	snapshotURL, err := dashboardSnapshotURLWithFormat("https://gorgon.nr-assets.net/image/abc?config.legend.enabled=false&format=PDF", "PNG")
+	require.NoError(t, err)
+	require.Equal(t, "https://gorgon.nr-assets.net/image/abc?config.legend.enabled=false&format=PNG", snapshotURL)
+}
+
+func TestWriteDashboardSnapshot(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/snapshot" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "dashboard.pdf")
+
+	require.NoError(t, writeDashboardSnapshot(context.Background(), server.URL+"/snapshot", path))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "%PDF-1.4", string(content))
+
+	require.EqualError(t, writeDashboardSnapshot(context.Background(), server.URL+"/expired", path), "error downloading dashboard snapshot: 404 Not Found")
+}
@@ -0,0 +1,169 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNewRelicAlertMutingRuleTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicAlertMutingRuleTestRead,
+		Schema: map[string]*schema.Schema{
+			"condition": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The condition of the muting rule to evaluate, as in the `condition` block of a muting rule.",
+				MaxItems:    1,
+				MinItems:    1,
+				Elem:        mutingRuleConditionGroupSchema(),
+			},
+			"schedule": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        scheduleSchema(),
+				Description: "The schedule of the muting rule to evaluate, as in the `schedule` block of a muting rule.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the muting rule is enabled.",
+			},
+			"timestamp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The RFC3339 timestamp at which the schedule is evaluated. Defaults to the current time.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"sample": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Sample incidents to evaluate the condition against.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the sample incident.",
+						},
+						"attributes": {
+							Type:        schema.TypeMap,
+							Required:    true,
+							Description: "The attributes of the sample incident. Attributes holding several values are given as JSON arrays.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"muting_active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the muting rule is enabled and its schedule is active at the evaluated timestamp.",
+			},
+			"result": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the evaluation of each sample incident.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the sample incident.",
+						},
+						"matched": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the sample incident matches the condition.",
+						},
+						"muted": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the sample incident would be muted at the evaluated timestamp.",
+						},
+					},
+				},
+			},
+			"muted_samples": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the sample incidents which would be muted at the evaluated timestamp.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicAlertMutingRuleTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	condition := expandMutingRuleConditionGroup(d.Get("condition").([]interface{})[0].(map[string]interface{}))
+
+	timestamp := time.Now()
+	if t, ok := d.GetOk("timestamp"); ok {
+		parsed, err := time.Parse(time.RFC3339, t.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		timestamp = parsed
+	}
+
+	active := d.Get("enabled").(bool)
+	if s, ok := d.GetOk("schedule"); ok && active {
+		schedule, err := expandMutingRuleCreateSchedule(s.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		active, err = isMutingRuleScheduleActive(schedule, timestamp)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error evaluating muting rule schedule: %v", err))
+		}
+	}
+
+	results := []interface{}{}
+	mutedSamples := []string{}
+	for _, s := range d.Get("sample").([]interface{}) {
+		sample := s.(map[string]interface{})
+
+		attributes := map[string][]string{}
+		for k, v := range sample["attributes"].(map[string]interface{}) {
+			attributes[k] = parseSampleAttributeValues(v.(string))
+		}
+
+		matched := evaluateMutingRuleConditionGroup(condition, attributes)
+		muted := matched && active
+		if muted {
+			mutedSamples = append(mutedSamples, sample["name"].(string))
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    sample["name"],
+			"matched": matched,
+			"muted":   muted,
+		})
+	}
+
+	d.SetId(strconv.Itoa(rand.Int()))
+
+	if err := d.Set("muting_active", active); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("result", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("muted_samples", mutedSamples); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateMutingRuleCondition(t *testing.T) {
+	attributes := map[string][]string{
+		"policyName":    {"Checkout"},
+		"conditionName": {"High CPU on prod-web-1"},
+		"tags.env":      {"production", "eu"},
+		"targetName":    {""},
+	}
+
+	cases := map[string]struct {
+		Condition alerts.MutingRuleCondition
+		Expected  bool
+	}{
+		"equals": {
+			Condition: alerts.MutingRuleCondition{Attribute: "policyName", Operator: "EQUALS", Values: []string{"Checkout"}},
+			Expected:  true,
+		},
+		"in matches any value of a tag": {
+			Condition: alerts.MutingRuleCondition{Attribute: "tags.env", Operator: "IN", Values: []string{"staging", "eu"}},
+			Expected:  true,
+		},
+		"not in": {
+			Condition: alerts.MutingRuleCondition{Attribute: "tags.env", Operator: "NOT_IN", Values: []string{"eu"}},
+			Expected:  false,
+		},
+		"operators are case insensitive": {
+			Condition: alerts.MutingRuleCondition{Attribute: "conditionName", Operator: "contains", Values: []string{"prod"}},
+			Expected:  true,
+		},
+		"starts with": {
+			Condition: alerts.MutingRuleCondition{Attribute: "conditionName", Operator: "STARTS_WITH", Values: []string{"Low"}},
+			Expected:  false,
+		},
+		"not ends with": {
+			Condition: alerts.MutingRuleCondition{Attribute: "conditionName", Operator: "NOT_ENDS_WITH", Values: []string{"web-2"}},
+			Expected:  true,
+		},
+		"is blank on an empty attribute": {
+			Condition: alerts.MutingRuleCondition{Attribute: "targetName", Operator: "IS_BLANK", Values: []string{""}},
+			Expected:  true,
+		},
+		"is blank on a missing attribute": {
+			Condition: alerts.MutingRuleCondition{Attribute: "product", Operator: "IS_BLANK", Values: []string{""}},
+			Expected:  true,
+		},
+		"is not blank": {
+			Condition: alerts.MutingRuleCondition{Attribute: "policyName", Operator: "IS_NOT_BLANK", Values: []string{""}},
+			Expected:  true,
+		},
+		"negative operator on a missing attribute": {
+			Condition: alerts.MutingRuleCondition{Attribute: "product", Operator: "NOT_EQUALS", Values: []string{"APM"}},
+			Expected:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, evaluateMutingRuleCondition(tc.Condition, attributes))
+		})
+	}
+}
+
+func TestIsMutingRuleScheduleActive(t *testing.T) {
+	naive := func(value string) *alerts.NaiveDateTime {
+		parsed, err := time.Parse("2006-01-02T15:04:05", value)
+		require.NoError(t, err)
+		return &alerts.NaiveDateTime{Time: parsed}
+	}
+	repeat := func(r alerts.MutingRuleScheduleRepeat) *alerts.MutingRuleScheduleRepeat { return &r }
+	count := 2
+	days := []alerts.DayOfWeek{"MONDAY", "WEDNESDAY"}
+
+	cases := map[string]struct {
+		Schedule alerts.MutingRuleScheduleCreateInput
+		At       string
+		Expected bool
+	}{
+		"one time window": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-01T10:00:00"), EndTime: naive("2026-01-01T12:00:00")},
+			At:       "2026-01-01T11:00:00Z",
+			Expected: true,
+		},
+		"one time window in another time zone": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "America/New_York", StartTime: naive("2026-01-01T10:00:00"), EndTime: naive("2026-01-01T12:00:00")},
+			At:       "2026-01-01T11:00:00Z",
+			Expected: false,
+		},
+		"open ended window": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-01T10:00:00")},
+			At:       "2027-01-01T11:00:00Z",
+			Expected: true,
+		},
+		"daily repeat": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-01T22:00:00"), EndTime: naive("2026-01-02T02:00:00"), Repeat: repeat(alerts.MutingRuleScheduleRepeatTypes.DAILY)},
+			At:       "2026-03-10T01:00:00Z",
+			Expected: true,
+		},
+		"daily repeat after repeat count": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-01T22:00:00"), EndTime: naive("2026-01-02T02:00:00"), Repeat: repeat(alerts.MutingRuleScheduleRepeatTypes.DAILY), RepeatCount: &count},
+			At:       "2026-01-04T01:00:00Z",
+			Expected: false,
+		},
+		"weekly repeat on a repeat day": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-05T09:00:00"), EndTime: naive("2026-01-05T10:00:00"), Repeat: repeat(alerts.MutingRuleScheduleRepeatTypes.WEEKLY), WeeklyRepeatDays: &days},
+			At:       "2026-02-04T09:30:00Z",
+			Expected: true,
+		},
+		"weekly repeat on another day": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-05T09:00:00"), EndTime: naive("2026-01-05T10:00:00"), Repeat: repeat(alerts.MutingRuleScheduleRepeatTypes.WEEKLY), WeeklyRepeatDays: &days},
+			At:       "2026-02-05T09:30:00Z",
+			Expected: false,
+		},
+		"monthly repeat after end repeat": {
+			Schedule: alerts.MutingRuleScheduleCreateInput{TimeZone: "UTC", StartTime: naive("2026-01-15T00:00:00"), EndTime: naive("2026-01-16T00:00:00"), Repeat: repeat(alerts.MutingRuleScheduleRepeatTypes.MONTHLY), EndRepeat: naive("2026-03-01T00:00:00")},
+			At:       "2026-03-15T12:00:00Z",
+			Expected: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			at, err := time.Parse(time.RFC3339, tc.At)
+			require.NoError(t, err)
+
+			active, err := isMutingRuleScheduleActive(tc.Schedule, at)
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, active)
+		})
+	}
+}
+
+func TestDataSourceNewRelicAlertMutingRuleTestRead(t *testing.T) {
+	r := dataSourceNewRelicAlertMutingRuleTest()
+	require.NoError(t, r.InternalValidate(nil, false))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"condition": []interface{}{
+			map[string]interface{}{
+				"operator": "AND",
+				"conditions": []interface{}{
+					map[string]interface{}{"attribute": "policyName", "operator": "EQUALS", "values": []interface{}{"Checkout"}},
+					map[string]interface{}{"attribute": "tags.env", "operator": "IN", "values": []interface{}{"staging"}},
+				},
+			},
+		},
+		"schedule": []interface{}{
+			map[string]interface{}{
+				"time_zone":  "UTC",
+				"start_time": "2026-01-01T22:00:00",
+				"end_time":   "2026-01-02T02:00:00",
+				"repeat":     "DAILY",
+			},
+		},
+		"timestamp": "2026-02-01T23:00:00Z",
+		"sample": []interface{}{
+			map[string]interface{}{
+				"name":       "staging checkout",
+				"attributes": map[string]interface{}{"policyName": "Checkout", "tags.env": `["staging", "eu"]`},
+			},
+			map[string]interface{}{
+				"name":       "production checkout",
+				"attributes": map[string]interface{}{"policyName": "Checkout", "tags.env": "production"},
+			},
+		},
+	})
+
+	diags := dataSourceNewRelicAlertMutingRuleTestRead(context.Background(), d, nil)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, true, d.Get("muting_active"))
+	assert.Equal(t, []interface{}{"staging checkout"}, d.Get("muted_samples"))
+	assert.Equal(t, true, d.Get("result.0.muted"))
+	assert.Equal(t, false, d.Get("result.1.matched"))
+
+	require.NoError(t, d.Set("timestamp", "2026-02-01T12:00:00Z"))
+	diags = dataSourceNewRelicAlertMutingRuleTestRead(context.Background(), d, nil)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, false, d.Get("muting_active"))
+	assert.Equal(t, []interface{}{}, d.Get("muted_samples"))
+	assert.Equal(t, true, d.Get("result.0.matched"))
+	assert.Equal(t, false, d.Get("result.0.muted"))
+}
@@ -0,0 +1,65 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+func dataSourceNewRelicDashboardConvert() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicDashboardConvertRead,
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GUID of the newrelic_one_dashboard_raw dashboard to convert.",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the newrelic_one_dashboard resource in the HCL. Defaults to a name derived from the dashboard's name.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard's name.",
+			},
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard as a newrelic_one_dashboard resource.",
+			},
+			"unconverted_widgets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The widgets whose visualization has no widget block, and the configuration of widgets that widget blocks can't hold.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicDashboardConvertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	guid := d.Get("guid").(string)
+
+	log.Printf("[INFO] Converting New Relic One dashboard %s", guid)
+
+	dashboard, err := client.Dashboards.GetDashboardEntityWithContext(ctx, common.EntityGUID(guid))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return diag.FromErr(fmt.Errorf("no dashboard found with GUID %s", guid))
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenDashboardConvert(dashboard, d.Get("resource_name").(string), d))
+}
@@ -0,0 +1,46 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicDashboardConvertDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_dashboard_convert.foo"
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicOneDashboardRawDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicDashboardConvertDataSourceConfig(rName, strconv.Itoa(testAccountID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "hcl", regexp.MustCompile(`resource "newrelic_one_dashboard" "converted"`)),
+					resource.TestMatchResourceAttr(resourceName, "hcl", regexp.MustCompile(`widget_bar \{`)),
+					resource.TestCheckResourceAttr(resourceName, "unconverted_widgets.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicDashboardConvertDataSourceConfig(dashboardName string, accountID string) string {
+	return testAccCheckNewRelicOneDashboardRawConfig_OnePageFull(dashboardName, accountID) + `
+
+data "newrelic_dashboard_convert" "foo" {
+  guid          = newrelic_one_dashboard_raw.bar.guid
+  resource_name = "converted"
+}
+`
+}
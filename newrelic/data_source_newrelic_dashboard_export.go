@@ -0,0 +1,80 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
+)
+
+func dataSourceNewRelicDashboardExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicDashboardExportRead,
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GUID of the dashboard to export.",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the newrelic_one_dashboard resource in the exported HCL. Defaults to a name derived from the dashboard's name.",
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The New Relic account ID of the dashboard.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard's name.",
+			},
+			"permalink": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the dashboard.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard as a JSON document for the newrelic_one_dashboard_json resource.",
+			},
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The dashboard as a newrelic_one_dashboard resource.",
+			},
+			"unsupported_widgets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The widgets with a visualization that has no widget block in newrelic_one_dashboard, exported as widget_json blocks in the HCL.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicDashboardExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	guid := d.Get("guid").(string)
+
+	log.Printf("[INFO] Exporting New Relic One dashboard %s", guid)
+
+	dashboard, err := client.Dashboards.GetDashboardEntityWithContext(ctx, common.EntityGUID(guid))
+	if err != nil {
+		if _, ok := err.(*errors.NotFound); ok {
+			return diag.FromErr(fmt.Errorf("no dashboard found with GUID %s", guid))
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenDashboardExport(dashboard, d.Get("resource_name").(string), d))
+}
@@ -0,0 +1,47 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicDashboardExportDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_dashboard_export.foo"
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicOneDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicDashboardExportDataSourceConfig(rName, strconv.Itoa(testAccountID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "guid", "newrelic_one_dashboard.bar", "guid"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestMatchResourceAttr(resourceName, "json", regexp.MustCompile(`"id": "viz.bar"`)),
+					resource.TestMatchResourceAttr(resourceName, "hcl", regexp.MustCompile(`resource "newrelic_one_dashboard" "exported"`)),
+					resource.TestCheckResourceAttr(resourceName, "unsupported_widgets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicDashboardExportDataSourceConfig(dashboardName string, accountID string) string {
+	return testAccCheckNewRelicOneDashboardConfig_TwoPageBasic(dashboardName, accountID) + `
+
+data "newrelic_dashboard_export" "foo" {
+  guid          = newrelic_one_dashboard.bar.guid
+  resource_name = "exported"
+}
+`
+}
@@ -0,0 +1,117 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNewRelicDashboardSnapshot() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicDashboardSnapshotRead,
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GUID of the dashboard or dashboard page to take a snapshot of.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PDF",
+				ValidateFunc: validation.StringInSlice(dashboardSnapshotFormats, false),
+				Description:  fmt.Sprintf("The format of the snapshot. One of: (%s).", strings.Join(dashboardSnapshotFormats, ", ")),
+			},
+			"time_window": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The period of time the data of the snapshot is taken from. Defaults to the time window of the dashboard.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"begin_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "The start of the time window, in RFC3339 format.",
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							Description:  "The end of the time window, in RFC3339 format.",
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDashboardSnapshotDuration,
+							Description:  "The duration of the time window, such as `24h`.",
+						},
+					},
+				},
+			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A local path the snapshot is written to.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the snapshot.",
+			},
+		},
+	}
+}
+
+func validateDashboardSnapshotDuration(v interface{}, k string) (ws []string, errs []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("expected %s to be a duration such as 24h, got %q", k, v))
+	} else if duration <= 0 {
+		errs = append(errs, fmt.Errorf("expected %s to be positive, got %q", k, v))
+	}
+
+	return ws, errs
+}
+
+func dataSourceNewRelicDashboardSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	guid := d.Get("guid").(string)
+
+	timeWindow, err := expandDashboardSnapshotTimeWindow(d.Get("time_window").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating a snapshot of New Relic One dashboard %s", guid)
+
+	snapshotURL, err := createDashboardSnapshotURL(ctx, client, guid, timeWindow)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	snapshotURL, err = dashboardSnapshotURLWithFormat(snapshotURL, d.Get("format").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if path, ok := d.GetOk("output_path"); ok {
+		log.Printf("[INFO] Writing the snapshot of New Relic One dashboard %s to %s", guid, path)
+
+		if err := writeDashboardSnapshot(ctx, snapshotURL, path.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(guid)
+
+	return diag.FromErr(d.Set("url", snapshotURL))
+}
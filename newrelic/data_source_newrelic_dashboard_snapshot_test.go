@@ -0,0 +1,48 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicDashboardSnapshotDataSource_Basic(t *testing.T) {
+	resourceName := "data.newrelic_dashboard_snapshot.foo"
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicOneDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicDashboardSnapshotDataSourceConfig(rName, strconv.Itoa(testAccountID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "guid", "newrelic_one_dashboard.bar", "page.0.guid"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://.*format=PNG`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicDashboardSnapshotDataSourceConfig(dashboardName string, accountID string) string {
+	return testAccCheckNewRelicOneDashboardConfig_TwoPageBasic(dashboardName, accountID) + `
+
+data "newrelic_dashboard_snapshot" "foo" {
+  guid   = newrelic_one_dashboard.bar.page[0].guid
+  format = "PNG"
+
+  time_window {
+    duration = "24h"
+  }
+}
+`
+}
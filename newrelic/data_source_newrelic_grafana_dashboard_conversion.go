@@ -0,0 +1,71 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"math/rand"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNewRelicGrafanaDashboardConversion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicGrafanaDashboardConversionRead,
+		Schema: map[string]*schema.Schema{
+			"grafana_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "The JSON model of the Grafana dashboard to convert.",
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The account the queries of the converted dashboard are run against. Defaults to the account of the provider.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The converted dashboard, as a JSON document for newrelic_one_dashboard_json.",
+			},
+			"untranslated": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The panels, queries and variables of the Grafana dashboard that could not be translated.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicGrafanaDashboardConversionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Converting Grafana dashboard to a New Relic One dashboard")
+
+	conversion, err := convertGrafanaDashboard(d.Get("grafana_json").(string), accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	b, err := json.MarshalIndent(conversion.Document, "", "  ")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, u := range conversion.Untranslated {
+		log.Printf("[WARN] Grafana dashboard conversion: %s", u)
+	}
+
+	d.SetId(strconv.Itoa(rand.Int()))
+	_ = d.Set("account_id", accountID)
+	_ = d.Set("json", string(b))
+
+	return diag.FromErr(d.Set("untranslated", conversion.Untranslated))
+}
@@ -0,0 +1,58 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicGrafanaDashboardConversionDataSource_Basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicOneDashboardJsonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicGrafanaDashboardConversionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.newrelic_grafana_dashboard_conversion.foo", "untranslated.#", "1"),
+					resource.TestCheckResourceAttr("newrelic_one_dashboard_json.bar", "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicGrafanaDashboardConversionDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "newrelic_grafana_dashboard_conversion" "foo" {
+  grafana_json = jsonencode({
+    title = "%s"
+    panels = [
+      {
+        type    = "timeseries"
+        title   = "Load"
+        gridPos = { x = 0, y = 0, w = 12, h = 8 }
+        targets = [{ refId = "A", expr = "avg by (instance) (node_load1)" }]
+      },
+      {
+        type    = "piechart"
+        title   = "Share"
+        gridPos = { x = 12, y = 0, w = 12, h = 8 }
+      },
+    ]
+  })
+}
+
+resource "newrelic_one_dashboard_json" "bar" {
+  json = data.newrelic_grafana_dashboard_conversion.foo.json
+}
+`, name)
+}
@@ -0,0 +1,130 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNewRelicNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicNotificationChannelRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The ID of the channel.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The name of the channel.",
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account ID of the channel.",
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the destination of the channel.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the channel. One of: (%s).", strings.Join(listValidNotificationsChannelTypes(), ", ")),
+			},
+			"product": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the channel product. One of: (%s).", strings.Join(listValidNotificationsProductTypes(), ", ")),
+			},
+			"property": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Notification channel property type.",
+				Elem:        notificationsPropertySchema(),
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the channel is active.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the channel.",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Reading New Relic Notification Channel")
+
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+	updatedContext := updateContextWithAccountID(ctx, accountID)
+	sorter := notifications.AiNotificationsChannelSorter{}
+
+	idValue := d.Get("id").(string)
+	nameValue := d.Get("name").(string)
+
+	// Channels can only be filtered by ID, channels looked up by name are searched instead.
+	var filters ai.AiNotificationsChannelFilter
+	if idValue != "" {
+		filters = ai.AiNotificationsChannelFilter{ID: idValue}
+	}
+
+	matches := []notifications.AiNotificationsChannel{}
+	cursor := ""
+	for {
+		channelResponse, err := client.Notifications.GetChannelsWithContext(updatedContext, accountID, cursor, filters, sorter)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		errors := buildAiNotificationsResponseErrors(channelResponse.Errors)
+		if len(errors) > 0 {
+			return errors
+		}
+
+		for _, channel := range channelResponse.Entities {
+			if idValue != "" || channel.Name == nameValue {
+				matches = append(matches, channel)
+			}
+		}
+
+		if idValue != "" || channelResponse.NextCursor == "" {
+			break
+		}
+
+		cursor = channelResponse.NextCursor
+	}
+
+	if len(matches) == 0 {
+		if idValue != "" {
+			return diag.FromErr(fmt.Errorf("the id provided does not match any New Relic notification channel"))
+		}
+		return diag.FromErr(fmt.Errorf("the name provided does not match any New Relic notification channel"))
+	}
+
+	if len(matches) > 1 {
+		return diag.FromErr(fmt.Errorf("the name provided matches %d New Relic notification channels, use the id instead", len(matches)))
+	}
+
+	return diag.FromErr(flattenNotificationChannelDataSource(&matches[0], d))
+}
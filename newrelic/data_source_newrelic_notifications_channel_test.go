@@ -0,0 +1,106 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicNotificationChannelDataSource_ById(t *testing.T) {
+	resourceName := "data.newrelic_notification_channel.foo"
+	rand := acctest.RandString(5)
+	rName := fmt.Sprintf("tf-notifications-test-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicNotificationsChannelDataSourceConfig(rName, "id = newrelic_notification_channel.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicNotificationChannelDataSource(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "WEBHOOK"),
+					resource.TestCheckResourceAttr(resourceName, "product", "IINT"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_id", "newrelic_notification_destination.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicNotificationChannelDataSource_ByName(t *testing.T) {
+	resourceName := "data.newrelic_notification_channel.foo"
+	rand := acctest.RandString(5)
+	rName := fmt.Sprintf("tf-notifications-test-%s", rand)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicNotificationsChannelDataSourceConfig(rName, "name = newrelic_notification_channel.foo.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicNotificationChannelDataSource(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "newrelic_notification_channel.foo", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "WEBHOOK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicNotificationsChannelDataSourceConfig(name string, lookup string) string {
+	return fmt.Sprintf(`
+	resource "newrelic_notification_destination" "foo" {
+	  name   = "%[1]s"
+	  type   = "WEBHOOK"
+	  active = true
+
+	  property {
+		key   = "url"
+		value = "https://webhook.site/"
+	  }
+	}
+
+	resource "newrelic_notification_channel" "foo" {
+	  name           = "%[1]s"
+	  type           = "WEBHOOK"
+	  product        = "IINT"
+	  destination_id = newrelic_notification_destination.foo.id
+
+	  property {
+		key   = "payload"
+		value = "{}"
+	  }
+	}
+
+	data "newrelic_notification_channel" "foo" {
+	  %[2]s
+	}
+`, name, lookup)
+}
+
+func testAccNewRelicNotificationChannelDataSource(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := s.RootModule().Resources[n]
+		id := r.Primary.ID
+		a := r.Primary.Attributes
+
+		if id == "" {
+			return fmt.Errorf("expected to get a notification channel id from New Relic")
+		}
+
+		if a["name"] == "" {
+			return fmt.Errorf("expected to get a notification channel from New Relic")
+		}
+
+		return nil
+	}
+}
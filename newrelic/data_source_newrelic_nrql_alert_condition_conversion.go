@@ -0,0 +1,234 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var nrqlConditionConversionSources = []string{"alert_condition_id", "infra_alert_condition_id", "alert_condition", "infra_alert_condition"}
+
+func dataSourceNewRelicNrqlAlertConditionConversion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicNrqlAlertConditionConversionRead,
+		Schema: map[string]*schema.Schema{
+			"policy_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"alert_condition_id"},
+				Description:  "The ID of the policy of the APM alert condition to convert.",
+			},
+			"alert_condition_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: nrqlConditionConversionSources,
+				RequiredWith: []string{"policy_id"},
+				Description:  "The ID of an existing APM alert condition to convert.",
+			},
+			"infra_alert_condition_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The ID of an existing infrastructure alert condition to convert.",
+			},
+			"alert_condition": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        nrqlConditionConversionSourceSchema(resourceNewRelicAlertCondition()),
+				Description: "The attributes of a `newrelic_alert_condition` resource to convert.",
+			},
+			"infra_alert_condition": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        nrqlConditionConversionSourceSchema(resourceNewRelicInfraAlertCondition()),
+				Description: "The attributes of a `newrelic_infra_alert_condition` resource to convert.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the converted condition.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the converted condition is enabled.",
+			},
+			"runbook_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The runbook URL of the converted condition.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the converted condition.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the equivalent NRQL alert condition.",
+			},
+			"nrql": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The NRQL query equivalent to the converted condition.",
+			},
+			"critical": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        nrqlConditionConversionTermSchema(),
+				Description: "The critical threshold of the equivalent NRQL alert condition.",
+			},
+			"warning": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        nrqlConditionConversionTermSchema(),
+				Description: "The warning threshold of the equivalent NRQL alert condition.",
+			},
+			"aggregation_window": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"aggregation_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"aggregation_delay": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fill_option": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fill_value": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"expiration_duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"open_violation_on_expiration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"close_violations_on_expiration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"violation_time_limit_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func nrqlConditionConversionTermSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"operator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"threshold_duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"threshold_occurrences": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicNrqlAlertConditionConversionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	var conversion *nrqlConditionConversion
+
+	id := strconv.Itoa(rand.Int())
+
+	if conditionID, ok := d.GetOk("alert_condition_id"); ok {
+		log.Printf("[INFO] Converting New Relic alert condition %d", conditionID.(int))
+
+		condition, err := client.Alerts.GetConditionWithContext(ctx, d.Get("policy_id").(int), conditionID.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		id = strconv.Itoa(condition.ID)
+		conversion, err = convertAlertConditionToNrql(condition)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if conditionID, ok := d.GetOk("infra_alert_condition_id"); ok {
+		log.Printf("[INFO] Converting New Relic infrastructure alert condition %d", conditionID.(int))
+
+		condition, err := client.Alerts.GetInfrastructureConditionWithContext(ctx, conditionID.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		id = strconv.Itoa(condition.ID)
+		conversion, err = convertInfraAlertConditionToNrql(condition)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if block, ok := d.GetOk("alert_condition.0"); ok {
+		source, err := resourceDataFromAttributes(resourceNewRelicAlertCondition(), block.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		condition, err := expandAlertCondition(source)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		conversion, err = convertAlertConditionToNrql(condition)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if block, ok := d.GetOk("infra_alert_condition.0"); ok {
+		source, err := resourceDataFromAttributes(resourceNewRelicInfraAlertCondition(), block.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		condition, err := expandInfraAlertCondition(source)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		conversion, err = convertInfraAlertConditionToNrql(condition)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if conversion == nil {
+		return diag.Errorf("no alert condition to convert")
+	}
+
+	d.SetId(id)
+
+	return diag.FromErr(flattenNrqlConditionConversion(conversion, d))
+}
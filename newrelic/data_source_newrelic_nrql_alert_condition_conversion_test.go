@@ -0,0 +1,79 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNewRelicNrqlAlertConditionConversionDataSource_InfraAlertCondition(t *testing.T) {
+	resourceName := "data.newrelic_nrql_alert_condition_conversion.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicNrqlAlertConditionConversionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "static"),
+					resource.TestCheckResourceAttr(resourceName, "nrql", "SELECT average(cpuPercent) FROM SystemSample WHERE (hostname LIKE '%cassandra%') FACET entityGuid"),
+					resource.TestCheckResourceAttr(resourceName, "critical.0.threshold_duration", "300"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_window", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicNrqlAlertConditionConversionDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+	name = "tf-test-%[1]s"
+}
+
+data "newrelic_nrql_alert_condition_conversion" "foo" {
+	infra_alert_condition {
+		name       = "tf-test-%[1]s"
+		type       = "infra_metric"
+		event      = "SystemSample"
+		select     = "cpuPercent"
+		comparison = "above"
+		where      = "hostname LIKE '%%cassandra%%'"
+
+		critical {
+			duration      = 5
+			value         = 90
+			time_function = "all"
+		}
+	}
+}
+
+resource "newrelic_nrql_alert_condition" "foo" {
+	policy_id                    = newrelic_alert_policy.foo.id
+	name                         = data.newrelic_nrql_alert_condition_conversion.foo.name
+	type                         = data.newrelic_nrql_alert_condition_conversion.foo.type
+	aggregation_window           = data.newrelic_nrql_alert_condition_conversion.foo.aggregation_window
+	aggregation_method           = data.newrelic_nrql_alert_condition_conversion.foo.aggregation_method
+	aggregation_delay            = data.newrelic_nrql_alert_condition_conversion.foo.aggregation_delay
+	violation_time_limit_seconds = data.newrelic_nrql_alert_condition_conversion.foo.violation_time_limit_seconds
+
+	nrql {
+		query = data.newrelic_nrql_alert_condition_conversion.foo.nrql
+	}
+
+	critical {
+		operator              = data.newrelic_nrql_alert_condition_conversion.foo.critical.0.operator
+		threshold             = data.newrelic_nrql_alert_condition_conversion.foo.critical.0.threshold
+		threshold_duration    = data.newrelic_nrql_alert_condition_conversion.foo.critical.0.threshold_duration
+		threshold_occurrences = data.newrelic_nrql_alert_condition_conversion.foo.critical.0.threshold_occurrences
+	}
+}
+`, name)
+}
@@ -0,0 +1,261 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNewRelicWorkflow() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicWorkflowRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The ID of the workflow.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The name of the workflow.",
+			},
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The account ID of the workflow.",
+			},
+			"destination": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Workflow's destination configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Destination's channel id.",
+						},
+						"notification_triggers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of triggers to notify about in this destination configuration.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Destination's name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The type of the destination. One of: (%s).", strings.Join(listValidWorkflowsDestinationTypes(), ", ")),
+						},
+					},
+				},
+			},
+			"issues_filter": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The issues filter of the workflow.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filter_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Filter's id.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Filter's name.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The type of the filter. One of: (%s).", strings.Join(listValidWorkflowsFilterTypes(), ", ")),
+						},
+						"predicate": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The predicates of the filter.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The attribute of the predicate.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: fmt.Sprintf("The operator of the predicate. One of: (%s).", strings.Join(listValidWorkflowsOperatorTypes(), ", ")),
+									},
+									"values": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The values of the predicate.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"enrichments": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Enrichments of the workflow.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nrql": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Nrql type Enrichments.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Enrichment's name.",
+									},
+									"configuration": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "A set of key-value pairs to represent a enrichment configuration.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"query": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "enrichment's NRQL query",
+												},
+											},
+										},
+									},
+									"account_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The account id of the enrichment.",
+									},
+									"type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: fmt.Sprintf("The type of the enrichment. One of: (%s).", strings.Join(listValidWorkflowsEnrichmentTypes(), ", ")),
+									},
+									"enrichment_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Enrichment's id.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"muting_rules_handling": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the muting rule handling. One of: (%s).", strings.Join(listValidMutingRulesTypes(), ", ")),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the workflow is enabled.",
+			},
+			"enrichments_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the enrichments are enabled.",
+			},
+			"destinations_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the destinations are enabled.",
+			},
+			"last_run": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time notification was sent for this workflow.",
+			},
+			"workflow_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the workflow.",
+			},
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Workflow entity GUID",
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Reading New Relic Workflow")
+
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+	updatedContext := updateContextWithAccountID(ctx, accountID)
+
+	idValue := d.Get("id").(string)
+	nameValue := d.Get("name").(string)
+
+	// Workflows can only be filtered by ID, workflows looked up by name are searched instead.
+	var filters ai.AiWorkflowsFilters
+	if idValue != "" {
+		filters = ai.AiWorkflowsFilters{ID: idValue}
+	}
+
+	matches := []workflows.AiWorkflowsWorkflow{}
+	cursor := ""
+	for {
+		workflowResponse, err := client.Workflows.GetWorkflowsWithContext(updatedContext, accountID, cursor, filters)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, workflow := range workflowResponse.Entities {
+			if idValue != "" || workflow.Name == nameValue {
+				matches = append(matches, workflow)
+			}
+		}
+
+		if idValue != "" || workflowResponse.NextCursor == "" {
+			break
+		}
+
+		cursor = workflowResponse.NextCursor
+	}
+
+	if len(matches) == 0 {
+		if idValue != "" {
+			return diag.FromErr(fmt.Errorf("the id provided does not match any New Relic workflow"))
+		}
+		return diag.FromErr(fmt.Errorf("the name provided does not match any New Relic workflow"))
+	}
+
+	if len(matches) > 1 {
+		return diag.FromErr(fmt.Errorf("the name provided matches %d New Relic workflows, use the id instead", len(matches)))
+	}
+
+	d.SetId(matches[0].ID)
+
+	return diag.FromErr(flattenWorkflow(&matches[0], d))
+}
@@ -0,0 +1,128 @@
+package newrelic
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+)
+
+func dataSourceNewRelicWorkflowFilterTest() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNewRelicWorkflowFilterTestRead,
+		Schema: map[string]*schema.Schema{
+			"predicate": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The predicates of the issues filter to evaluate, as in the `issues_filter` block of a workflow.",
+				Elem:        workflowIssuesFilterPredicateSchema(),
+			},
+			"sample": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Sample issues to evaluate the predicates against.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the sample issue.",
+						},
+						"attributes": {
+							Type:        schema.TypeMap,
+							Required:    true,
+							Description: "The attributes of the sample issue. Attributes holding several values are given as JSON arrays.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"result": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the evaluation of each sample issue.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the sample issue.",
+						},
+						"matched": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the sample issue matches all the predicates.",
+						},
+						"failed_predicates": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The indexes of the predicates which the sample issue does not match.",
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"matched_samples": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the sample issues matching all the predicates.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNewRelicWorkflowFilterTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	predicates := []workflowFilterPredicate{}
+	for _, p := range d.Get("predicate").([]interface{}) {
+		predicate := p.(map[string]interface{})
+
+		values := []string{}
+		for _, v := range predicate["values"].([]interface{}) {
+			values = append(values, v.(string))
+		}
+
+		predicates = append(predicates, workflowFilterPredicate{
+			Attribute: predicate["attribute"].(string),
+			Operator:  workflows.AiWorkflowsOperator(predicate["operator"].(string)),
+			Values:    values,
+		})
+	}
+
+	results := []interface{}{}
+	matchedSamples := []string{}
+	for _, s := range d.Get("sample").([]interface{}) {
+		sample := s.(map[string]interface{})
+
+		attributes := map[string][]string{}
+		for k, v := range sample["attributes"].(map[string]interface{}) {
+			attributes[k] = parseSampleAttributeValues(v.(string))
+		}
+
+		matched, failed := evaluateWorkflowFilter(predicates, attributes)
+		if matched {
+			matchedSamples = append(matchedSamples, sample["name"].(string))
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":              sample["name"],
+			"matched":           matched,
+			"failed_predicates": failed,
+		})
+	}
+
+	d.SetId(strconv.Itoa(rand.Int()))
+
+	if err := d.Set("result", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("matched_samples", matchedSamples); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
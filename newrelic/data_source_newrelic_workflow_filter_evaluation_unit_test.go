@@ -0,0 +1,117 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateWorkflowFilterPredicate(t *testing.T) {
+	attributes := map[string][]string{
+		"labels.policyIds":            {"123", "456"},
+		"priority":                    {"CRITICAL"},
+		"accumulations.conditionName": {"High CPU on prod-web-1"},
+		"totalIncidents":              {"3"},
+	}
+
+	cases := map[string]struct {
+		Predicate workflowFilterPredicate
+		Expected  bool
+	}{
+		"exactly matches one of the attribute values": {
+			Predicate: workflowFilterPredicate{Attribute: "labels.policyIds", Operator: workflows.AiWorkflowsOperatorTypes.EXACTLY_MATCHES, Values: []string{"456"}},
+			Expected:  true,
+		},
+		"does not exactly match": {
+			Predicate: workflowFilterPredicate{Attribute: "labels.policyIds", Operator: workflows.AiWorkflowsOperatorTypes.DOES_NOT_EXACTLY_MATCH, Values: []string{"456"}},
+			Expected:  false,
+		},
+		"is": {
+			Predicate: workflowFilterPredicate{Attribute: "priority", Operator: workflows.AiWorkflowsOperatorTypes.IS, Values: []string{"CRITICAL"}},
+			Expected:  true,
+		},
+		"is not is case sensitive": {
+			Predicate: workflowFilterPredicate{Attribute: "priority", Operator: workflows.AiWorkflowsOperatorTypes.IS_NOT, Values: []string{"critical"}},
+			Expected:  true,
+		},
+		"contains": {
+			Predicate: workflowFilterPredicate{Attribute: "accumulations.conditionName", Operator: workflows.AiWorkflowsOperatorTypes.CONTAINS, Values: []string{"prod"}},
+			Expected:  true,
+		},
+		"does not contain": {
+			Predicate: workflowFilterPredicate{Attribute: "accumulations.conditionName", Operator: workflows.AiWorkflowsOperatorTypes.DOES_NOT_CONTAIN, Values: []string{"staging"}},
+			Expected:  true,
+		},
+		"starts with": {
+			Predicate: workflowFilterPredicate{Attribute: "accumulations.conditionName", Operator: workflows.AiWorkflowsOperatorTypes.STARTS_WITH, Values: []string{"Low", "High"}},
+			Expected:  true,
+		},
+		"ends with": {
+			Predicate: workflowFilterPredicate{Attribute: "accumulations.conditionName", Operator: workflows.AiWorkflowsOperatorTypes.ENDS_WITH, Values: []string{"web-2"}},
+			Expected:  false,
+		},
+		"greater than": {
+			Predicate: workflowFilterPredicate{Attribute: "totalIncidents", Operator: workflows.AiWorkflowsOperatorTypes.GREATER_THAN, Values: []string{"2"}},
+			Expected:  true,
+		},
+		"less or equal with a non numeric value": {
+			Predicate: workflowFilterPredicate{Attribute: "priority", Operator: workflows.AiWorkflowsOperatorTypes.LESS_OR_EQUAL, Values: []string{"2"}},
+			Expected:  false,
+		},
+		"positive operator on a missing attribute": {
+			Predicate: workflowFilterPredicate{Attribute: "entitiesData.types", Operator: workflows.AiWorkflowsOperatorTypes.EXACTLY_MATCHES, Values: []string{"HOST"}},
+			Expected:  false,
+		},
+		"negative operator on a missing attribute": {
+			Predicate: workflowFilterPredicate{Attribute: "entitiesData.types", Operator: workflows.AiWorkflowsOperatorTypes.DOES_NOT_EXACTLY_MATCH, Values: []string{"HOST"}},
+			Expected:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, evaluateWorkflowFilterPredicate(tc.Predicate, attributes))
+		})
+	}
+}
+
+func TestDataSourceNewRelicWorkflowFilterTestRead(t *testing.T) {
+	r := dataSourceNewRelicWorkflowFilterTest()
+	require.NoError(t, r.InternalValidate(nil, false))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"predicate": []interface{}{
+			map[string]interface{}{"attribute": "labels.policyIds", "operator": "EXACTLY_MATCHES", "values": []interface{}{"123"}},
+			map[string]interface{}{"attribute": "priority", "operator": "IS", "values": []interface{}{"CRITICAL"}},
+		},
+		"sample": []interface{}{
+			map[string]interface{}{
+				"name":       "critical",
+				"attributes": map[string]interface{}{"labels.policyIds": `["123", "456"]`, "priority": "CRITICAL"},
+			},
+			map[string]interface{}{
+				"name":       "warning",
+				"attributes": map[string]interface{}{"labels.policyIds": `["123"]`, "priority": "HIGH"},
+			},
+			map[string]interface{}{
+				"name":       "other policy",
+				"attributes": map[string]interface{}{"labels.policyIds": "789", "priority": "HIGH"},
+			},
+		},
+	})
+
+	diags := dataSourceNewRelicWorkflowFilterTestRead(context.Background(), d, nil)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, []interface{}{"critical"}, d.Get("matched_samples"))
+	assert.Equal(t, true, d.Get("result.0.matched"))
+	assert.Equal(t, []interface{}{1}, d.Get("result.1.failed_predicates"))
+	assert.Equal(t, []interface{}{0, 1}, d.Get("result.2.failed_predicates"))
+}
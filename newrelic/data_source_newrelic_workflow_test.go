@@ -0,0 +1,133 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicWorkflowDataSource_ById(t *testing.T) {
+	resourceName := "data.newrelic_workflow.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicWorkflowDataSourceConfig(rName, "id = newrelic_workflow.foo.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicWorkflowDataSource(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "muting_rules_handling", "NOTIFY_ALL_ISSUES"),
+					resource.TestCheckResourceAttr(resourceName, "issues_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enrichments.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicWorkflowDataSource_ByName(t *testing.T) {
+	resourceName := "data.newrelic_workflow.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnvVars(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicWorkflowDataSourceConfig(rName, "name = newrelic_workflow.foo.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNewRelicWorkflowDataSource(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", "newrelic_workflow.foo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_id", "newrelic_workflow.foo", "workflow_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNewRelicWorkflowDataSourceConfig(name string, lookup string) string {
+	return fmt.Sprintf(`
+resource "newrelic_notification_destination" "foo" {
+  name = "tf-test-destination"
+  type = "WEBHOOK"
+
+  property {
+    key   = "url"
+    value = "https://webhook.site/"
+  }
+}
+
+resource "newrelic_notification_channel" "foo" {
+  name           = "webhook-example"
+  type           = "WEBHOOK"
+  product        = "IINT"
+  destination_id = newrelic_notification_destination.foo.id
+
+  property {
+    key   = "payload"
+    value = "{}"
+  }
+}
+
+resource "newrelic_workflow" "foo" {
+  name                  = "%[1]s"
+  muting_rules_handling = "NOTIFY_ALL_ISSUES"
+
+  issues_filter {
+    name = "filter-name"
+    type = "FILTER"
+
+    predicate {
+      attribute = "accumulations.sources"
+      operator  = "EQUAL"
+      values    = ["newrelic"]
+    }
+  }
+
+  enrichments {
+    nrql {
+      name = "Log"
+      configuration {
+        query = "SELECT count(*) FROM Log"
+      }
+    }
+  }
+
+  destination {
+    channel_id = newrelic_notification_channel.foo.id
+  }
+}
+
+data "newrelic_workflow" "foo" {
+  %[2]s
+}
+`, name, lookup)
+}
+
+func testAccNewRelicWorkflowDataSource(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := s.RootModule().Resources[n]
+		id := r.Primary.ID
+		a := r.Primary.Attributes
+
+		if id == "" {
+			return fmt.Errorf("expected to get a workflow id from New Relic")
+		}
+
+		if a["name"] == "" {
+			return fmt.Errorf("expected to get a workflow from New Relic")
+		}
+
+		return nil
+	}
+}
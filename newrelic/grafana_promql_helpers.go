@@ -0,0 +1,446 @@
+package newrelic
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The PromQL aggregations translated to NRQL, and their NRQL functions.
+var grafanaPromQLAggregations = map[string]string{
+	"avg":   "average",
+	"count": "count",
+	"max":   "max",
+	"min":   "min",
+	"sum":   "sum",
+}
+
+var (
+	grafanaPromQLIdentifier  = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*`)
+	grafanaPromQLRange       = regexp.MustCompile(`^\[(?:[^\[\]]|\[\[\w+\]\])+\]`)
+	grafanaPromQLNumber      = regexp.MustCompile(`^[0-9]*\.?[0-9]+`)
+	grafanaPromQLVariable    = regexp.MustCompile(`^(?:\$(\w+)|\$\{(\w+)(?::\w+)?\}|\[\[(\w+)\]\])$`)
+	grafanaPromQLVariableAny = regexp.MustCompile(`\$(\w+)|\$\{(\w+)(?::\w+)?\}|\[\[(\w+)\]\]`)
+	grafanaNRQLIdentifier    = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// grafanaPromQLQuery is a PromQL query of the subset translated to NRQL: an optional aggregation
+// over an optional rate of a selector, or a histogram quantile of a rate of buckets.
+type grafanaPromQLQuery struct {
+	Metric      string
+	Matchers    []grafanaPromQLMatcher
+	Rate        bool
+	Aggregation string
+	By          []string
+	Quantile    float64
+}
+
+type grafanaPromQLMatcher struct {
+	Label    string
+	Operator string
+	Value    string
+}
+
+type grafanaPromQLParser struct {
+	input string
+	pos   int
+}
+
+// translateGrafanaPromQL translates a PromQL query to NRQL on the Metric event type. Queries
+// outside of the supported subset return an error describing what could not be translated, the
+// notes describe how the translated query differs from the PromQL query.
+func translateGrafanaPromQL(promQL string, timeseries bool) (string, []string, error) {
+	p := &grafanaPromQLParser{input: promQL}
+
+	query, err := p.parseExpression()
+	if err != nil {
+		return "", nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return "", nil, fmt.Errorf("unsupported PromQL %q", p.input[p.pos:])
+	}
+
+	notes := []string{}
+	if query.Aggregation == "" && query.Quantile == 0 {
+		combined := "averaged"
+		if query.Rate {
+			combined = "summed"
+		}
+
+		if len(query.By) > 0 {
+			notes = append(notes, fmt.Sprintf("the series of %s are only kept apart by %s and %s otherwise, aggregate the query by the labels to keep apart", query.Metric, strings.Join(query.By, ", "), combined))
+		} else {
+			notes = append(notes, fmt.Sprintf("the series of %s are %s into one, aggregate the query by the labels to keep apart", query.Metric, combined))
+		}
+	}
+
+	return query.nrql(timeseries), notes, nil
+}
+
+func (p *grafanaPromQLParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *grafanaPromQLParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+
+	return false
+}
+
+func (p *grafanaPromQLParser) expect(token string) error {
+	if !p.consume(token) {
+		return fmt.Errorf("expected %q at position %d of %q", token, p.pos, p.input)
+	}
+
+	return nil
+}
+
+func (p *grafanaPromQLParser) identifier() string {
+	p.skipSpaces()
+	id := grafanaPromQLIdentifier.FindString(p.input[p.pos:])
+	p.pos += len(id)
+
+	return id
+}
+
+func (p *grafanaPromQLParser) parseExpression() (*grafanaPromQLQuery, error) {
+	start := p.pos
+	name := p.identifier()
+	if name == "" {
+		return nil, fmt.Errorf("unsupported PromQL %q", p.input[start:])
+	}
+
+	if _, ok := grafanaPromQLAggregations[name]; ok {
+		return p.parseAggregation(name)
+	}
+
+	switch name {
+	case "rate":
+		return p.parseRate()
+	case "histogram_quantile":
+		return p.parseHistogramQuantile()
+	case "by", "without", "on", "ignoring", "offset":
+		return nil, fmt.Errorf("unsupported PromQL keyword %q", name)
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		return nil, fmt.Errorf("unsupported PromQL function %q", name)
+	}
+
+	return p.parseSelector(name)
+}
+
+// parseAggregation parses `sum by (a) (expr)` and `sum(expr) by (a)`.
+func (p *grafanaPromQLParser) parseAggregation(name string) (*grafanaPromQLQuery, error) {
+	by, err := p.parseGrouping()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	query, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	if by == nil {
+		if by, err = p.parseGrouping(); err != nil {
+			return nil, err
+		}
+	}
+
+	if query.Aggregation != "" || query.Quantile > 0 {
+		return nil, fmt.Errorf("unsupported nested aggregation %q", name)
+	}
+
+	if query.Rate && name != "sum" {
+		return nil, fmt.Errorf("unsupported aggregation %q of a rate, only sum is translated", name)
+	}
+
+	query.Aggregation = name
+	query.By = by
+
+	return query, nil
+}
+
+func (p *grafanaPromQLParser) parseGrouping() ([]string, error) {
+	start := p.pos
+	switch p.identifier() {
+	case "by":
+	case "without":
+		return nil, fmt.Errorf("unsupported grouping \"without\"")
+	default:
+		p.pos = start
+		return nil, nil
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	labels := []string{}
+	for !p.consume(")") {
+		label := p.identifier()
+		if label == "" {
+			return nil, fmt.Errorf("expected a label at position %d of %q", p.pos, p.input)
+		}
+		labels = append(labels, label)
+		p.consume(",")
+	}
+
+	return labels, nil
+}
+
+func (p *grafanaPromQLParser) parseRate() (*grafanaPromQLQuery, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	name := p.identifier()
+	if name == "" {
+		return nil, fmt.Errorf("unsupported rate of %q, only rates of metrics are translated", p.input[p.pos:])
+	}
+
+	query, err := p.parseSelector(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The range of the rate, a duration or a Grafana interval variable such as $__rate_interval,
+	// is replaced by the buckets of the timeseries
+	p.skipSpaces()
+	rateRange := grafanaPromQLRange.FindString(p.input[p.pos:])
+	if rateRange == "" {
+		return nil, fmt.Errorf("expected a range at position %d of %q", p.pos, p.input)
+	}
+	p.pos += len(rateRange)
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	query.Rate = true
+
+	return query, nil
+}
+
+// parseHistogramQuantile parses `histogram_quantile(0.95, sum(rate(metric_bucket[5m])) by (le))`.
+func (p *grafanaPromQLParser) parseHistogramQuantile() (*grafanaPromQLQuery, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	number := grafanaPromQLNumber.FindString(p.input[p.pos:])
+	p.pos += len(number)
+	quantile, err := strconv.ParseFloat(number, 64)
+	if err != nil || quantile <= 0 || quantile > 1 {
+		return nil, fmt.Errorf("unsupported quantile %q", number)
+	}
+
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+
+	query, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(query.Metric, "_bucket") {
+		return nil, fmt.Errorf("unsupported histogram quantile of %q, only quantiles of buckets are translated", query.Metric)
+	}
+
+	by := []string{}
+	for _, label := range query.By {
+		if label != "le" {
+			by = append(by, label)
+		}
+	}
+
+	return &grafanaPromQLQuery{
+		Metric:   query.Metric,
+		Matchers: query.Matchers,
+		By:       by,
+		Quantile: quantile,
+	}, nil
+}
+
+func (p *grafanaPromQLParser) parseSelector(metric string) (*grafanaPromQLQuery, error) {
+	query := &grafanaPromQLQuery{Metric: metric}
+
+	if !p.consume("{") {
+		return query, nil
+	}
+
+	for !p.consume("}") {
+		label := p.identifier()
+		if label == "" {
+			return nil, fmt.Errorf("expected a label at position %d of %q", p.pos, p.input)
+		}
+
+		var operator string
+		for _, op := range []string{"=~", "!~", "!=", "="} {
+			if p.consume(op) {
+				operator = op
+				break
+			}
+		}
+		if operator == "" {
+			return nil, fmt.Errorf("expected a label matcher at position %d of %q", p.pos, p.input)
+		}
+
+		value, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+
+		matcher := grafanaPromQLMatcher{Label: label, Operator: operator, Value: value}
+		query.Matchers = append(query.Matchers, matcher)
+		p.consume(",")
+
+		// A selector returns a series for each label set, the series of labels matching several
+		// values are kept apart unless aggregated, which replaces the grouping
+		if matcher.multiValued() {
+			query.By = append(query.By, label)
+		}
+	}
+
+	return query, nil
+}
+
+// Whether a matcher may match several values of its label.
+func (m grafanaPromQLMatcher) multiValued() bool {
+	return m.Operator != "=" || grafanaVariableName(m.Value) != ""
+}
+
+func (p *grafanaPromQLParser) parseString() (string, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) || (p.input[p.pos] != '"' && p.input[p.pos] != '\'') {
+		return "", fmt.Errorf("expected a string at position %d of %q", p.pos, p.input)
+	}
+
+	quote := p.input[p.pos]
+	end := strings.IndexByte(p.input[p.pos+1:], quote)
+	if end < 0 {
+		return "", fmt.Errorf("unterminated string in %q", p.input)
+	}
+
+	value := p.input[p.pos+1 : p.pos+1+end]
+	p.pos += end + 2
+
+	return value, nil
+}
+
+func (q *grafanaPromQLQuery) nrql(timeseries bool) string {
+	var selection string
+	metric := grafanaNRQLIdentifierOf(q.Metric)
+
+	switch {
+	case q.Quantile > 0:
+		selection = fmt.Sprintf("bucketPercentile(%s, %s)", metric, strconv.FormatFloat(q.Quantile*100, 'f', -1, 64))
+	case q.Rate:
+		selection = fmt.Sprintf("rate(sum(%s), 1 SECOND)", metric)
+	case q.Aggregation != "":
+		selection = fmt.Sprintf("%s(%s)", grafanaPromQLAggregations[q.Aggregation], metric)
+	default:
+		selection = fmt.Sprintf("average(%s)", metric)
+	}
+
+	nrql := fmt.Sprintf("SELECT %s FROM Metric", selection)
+
+	if len(q.Matchers) > 0 {
+		conditions := make([]string, len(q.Matchers))
+		for i, m := range q.Matchers {
+			conditions[i] = m.nrql()
+		}
+		nrql += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	if len(q.By) > 0 {
+		facets := make([]string, len(q.By))
+		for i, label := range q.By {
+			facets[i] = grafanaNRQLIdentifierOf(label)
+		}
+		nrql += " FACET " + strings.Join(facets, ", ")
+	}
+
+	if timeseries {
+		nrql += " TIMESERIES"
+	}
+
+	return nrql
+}
+
+// A matcher on a Grafana variable matches any of its values, the variable is replaced by
+// the dashboard variable of the same name.
+func (m grafanaPromQLMatcher) nrql() string {
+	label := grafanaNRQLIdentifierOf(m.Label)
+	negated := strings.HasPrefix(m.Operator, "!")
+
+	if variable := grafanaVariableName(m.Value); variable != "" {
+		if negated {
+			return fmt.Sprintf("%s NOT IN ({{%s}})", label, variable)
+		}
+		return fmt.Sprintf("%s IN ({{%s}})", label, variable)
+	}
+
+	value := grafanaPromQLVariableAny.ReplaceAllStringFunc(m.Value, func(v string) string {
+		return "{{" + grafanaVariableName(v) + "}}"
+	})
+	value = "'" + strings.ReplaceAll(value, "'", "\\'") + "'"
+
+	switch m.Operator {
+	case "=~":
+		return fmt.Sprintf("%s RLIKE r%s", label, value)
+	case "!~":
+		return fmt.Sprintf("%s NOT RLIKE r%s", label, value)
+	case "!=":
+		return fmt.Sprintf("%s != %s", label, value)
+	}
+
+	return fmt.Sprintf("%s = %s", label, value)
+}
+
+// grafanaVariableName returns the name of the Grafana variable referenced by a value, in any of
+// the $var, ${var} or [[var]] syntaxes, or an empty string.
+func grafanaVariableName(value string) string {
+	match := grafanaPromQLVariable.FindStringSubmatch(value)
+	for i := 1; i < len(match); i++ {
+		if match[i] != "" {
+			return match[i]
+		}
+	}
+
+	return ""
+}
+
+// Names that are not plain NRQL identifiers, such as metrics of recording rules, are quoted.
+func grafanaNRQLIdentifierOf(name string) string {
+	if grafanaNRQLIdentifier.MatchString(name) {
+		return name
+	}
+
+	return "`" + name + "`"
+}
@@ -0,0 +1,124 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateGrafanaPromQL(t *testing.T) {
+	cases := map[string]struct {
+		PromQL      string
+		Timeseries  bool
+		Expected    string
+		ExpectedErr string
+		Note        string
+	}{
+		"selector": {
+			PromQL:   `node_load1`,
+			Expected: "SELECT average(node_load1) FROM Metric",
+			Note:     "the series of node_load1 are averaged into one, aggregate the query by the labels to keep apart",
+		},
+		"selector with matchers": {
+			PromQL:     `up{job="api", instance!="localhost:9090", env=~"prod|staging", zone!~'eu-.*'}`,
+			Timeseries: true,
+			Expected:   "SELECT average(up) FROM Metric WHERE job = 'api' AND instance != 'localhost:9090' AND env RLIKE r'prod|staging' AND zone NOT RLIKE r'eu-.*' FACET instance, env, zone TIMESERIES",
+			Note:       "the series of up are only kept apart by instance, env, zone and averaged otherwise, aggregate the query by the labels to keep apart",
+		},
+		"aggregation": {
+			PromQL:   `max by (instance) (node_memory_Active_bytes)`,
+			Expected: "SELECT max(node_memory_Active_bytes) FROM Metric FACET instance",
+		},
+		"rate": {
+			PromQL:     `sum(rate(http_requests_total{code=~"5.."}[5m])) by (service, code)`,
+			Timeseries: true,
+			Expected:   "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric WHERE code RLIKE r'5..' FACET service, code TIMESERIES",
+		},
+		"rate interval variable": {
+			PromQL:     `sum(rate(http_requests_total[$__rate_interval]))`,
+			Timeseries: true,
+			Expected:   "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric TIMESERIES",
+		},
+		"interval variable": {
+			PromQL:   `rate(http_requests_total{job="api"}[$__interval])`,
+			Expected: "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric WHERE job = 'api'",
+			Note:     "the series of http_requests_total are summed into one, aggregate the query by the labels to keep apart",
+		},
+		"braced interval variable": {
+			PromQL:   `rate(http_requests_total[${interval}])`,
+			Expected: "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric",
+			Note:     "the series of http_requests_total are summed into one, aggregate the query by the labels to keep apart",
+		},
+		"legacy interval variable": {
+			PromQL:   `rate(http_requests_total[[[interval]]])`,
+			Expected: "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric",
+			Note:     "the series of http_requests_total are summed into one, aggregate the query by the labels to keep apart",
+		},
+		"compound duration": {
+			PromQL:   `rate(http_requests_total[1m30s])`,
+			Expected: "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric",
+			Note:     "the series of http_requests_total are summed into one, aggregate the query by the labels to keep apart",
+		},
+		"milliseconds duration": {
+			PromQL:   `rate(http_requests_total[500ms])`,
+			Expected: "SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric",
+			Note:     "the series of http_requests_total are summed into one, aggregate the query by the labels to keep apart",
+		},
+		"missing range": {
+			PromQL:      `rate(http_requests_total[])`,
+			ExpectedErr: `expected a range at position 24 of "rate(http_requests_total[])"`,
+		},
+		"histogram quantile": {
+			PromQL:   `histogram_quantile(0.95, sum(rate(http_request_duration_seconds_bucket[5m])) by (le, route))`,
+			Expected: "SELECT bucketPercentile(http_request_duration_seconds_bucket, 95) FROM Metric FACET route",
+		},
+		"variables": {
+			PromQL:   `avg(node_load1{instance="$instance", job!="${job}", host="[[host]]-1"})`,
+			Expected: "SELECT average(node_load1) FROM Metric WHERE instance IN ({{instance}}) AND job NOT IN ({{job}}) AND host = '{{host}}-1'",
+		},
+		"recording rule": {
+			PromQL:   `job:http_requests:rate5m`,
+			Expected: "SELECT average(`job:http_requests:rate5m`) FROM Metric",
+			Note:     "the series of job:http_requests:rate5m are averaged into one, aggregate the query by the labels to keep apart",
+		},
+		"binary operator": {
+			PromQL:      `node_memory_Active_bytes / node_memory_MemTotal_bytes`,
+			ExpectedErr: `unsupported PromQL "/ node_memory_MemTotal_bytes"`,
+		},
+		"without": {
+			PromQL:      `sum without (instance) (up)`,
+			ExpectedErr: `unsupported grouping "without"`,
+		},
+		"function": {
+			PromQL:      `increase(http_requests_total[1h])`,
+			ExpectedErr: `unsupported PromQL function "increase"`,
+		},
+		"rate aggregation": {
+			PromQL:      `avg(rate(http_requests_total[5m]))`,
+			ExpectedErr: `unsupported aggregation "avg" of a rate, only sum is translated`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			nrql, notes, err := translateGrafanaPromQL(tc.PromQL, tc.Timeseries)
+			if tc.ExpectedErr != "" {
+				require.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, nrql)
+
+			if tc.Note != "" {
+				assert.Equal(t, []string{tc.Note}, notes)
+			} else {
+				assert.Empty(t, notes)
+			}
+		})
+	}
+}
@@ -0,0 +1,894 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The helpers below implement the subset of the Handlebars syntax used by New Relic
+// templates (incident titles, notification payloads) so templates can be validated
+// locally instead of failing once an incident is opened.
+
+type handlebarsNodeKind int
+
+const (
+	handlebarsTextNode handlebarsNodeKind = iota
+	handlebarsMustacheNode
+	handlebarsBlockNode
+)
+
+// handlebarsNode is a node of a parsed Handlebars template.
+type handlebarsNode struct {
+	Kind handlebarsNodeKind
+
+	// Text holds the literal content of text nodes.
+	Text string
+
+	// Name is the path or helper name of mustache and block nodes,
+	// e.g. `issueTitle` in `{{issueTitle}}` or `each` in `{{#each entities}}`.
+	Name string
+
+	// Params holds the positional parameters passed to a helper.
+	// Subexpressions are kept verbatim, including their parentheses.
+	Params []string
+
+	// Hash holds the `key=value` parameters passed to a helper.
+	Hash map[string]string
+
+	// Raw is set for triple-stash mustaches, e.g. `{{{json issueTitle}}}`.
+	Raw bool
+
+	// Children and Inverse hold the nodes of a block and of its `{{else}}` section.
+	Children []*handlebarsNode
+	Inverse  []*handlebarsNode
+
+	Line   int
+	Column int
+}
+
+// IsHelperCall reports whether a mustache is a helper call rather than a plain path lookup.
+func (n *handlebarsNode) IsHelperCall() bool {
+	return n.Kind == handlebarsBlockNode || len(n.Params) > 0 || len(n.Hash) > 0
+}
+
+type handlebarsSyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *handlebarsSyntaxError) Error() string {
+	return fmt.Sprintf("invalid template at line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+type handlebarsTag struct {
+	content string
+	raw     bool
+	line    int
+	column  int
+}
+
+// Parses a Handlebars template into a tree of nodes, checking that mustaches are
+// terminated, blocks are balanced and expressions are well formed.
+func parseHandlebarsTemplate(template string) ([]*handlebarsNode, error) {
+	root := &handlebarsNode{Kind: handlebarsBlockNode}
+	stack := []*handlebarsNode{root}
+	inverse := []bool{false}
+
+	// Chained blocks, e.g. `{{else if other}}`, are closed by the closing tag of the block they are chained to.
+	chained := []bool{false}
+
+	appendNode := func(node *handlebarsNode) {
+		parent := stack[len(stack)-1]
+		if inverse[len(inverse)-1] {
+			parent.Inverse = append(parent.Inverse, node)
+		} else {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	pos := 0
+	for pos < len(template) {
+		start := strings.Index(template[pos:], "{{")
+		if start < 0 {
+			appendNode(&handlebarsNode{Kind: handlebarsTextNode, Text: template[pos:]})
+			break
+		}
+		start += pos
+
+		// An escaped mustache, e.g. `\{{literal}}`, is plain text.
+		if start > 0 && template[start-1] == '\\' {
+			end := strings.Index(template[start:], "}}")
+			if end < 0 {
+				end = len(template) - start
+			} else {
+				end += 2
+			}
+			appendNode(&handlebarsNode{Kind: handlebarsTextNode, Text: template[pos:start-1] + template[start:start+end]})
+			pos = start + end
+			continue
+		}
+
+		if start > pos {
+			appendNode(&handlebarsNode{Kind: handlebarsTextNode, Text: template[pos:start]})
+		}
+
+		tag, next, err := readHandlebarsTag(template, start)
+		if err != nil {
+			return nil, err
+		}
+		pos = next
+
+		content := tag.content
+		switch {
+		case content == "":
+			return nil, tag.errorf("empty expression")
+
+		case content[0] == '!':
+			// Comments are ignored.
+			continue
+
+		case content[0] == '>':
+			return nil, tag.errorf("partials are not supported")
+
+		case content[0] == '#' || (content[0] == '^' && len(strings.TrimSpace(content[1:])) > 0):
+			node, err := parseHandlebarsExpression(tag, strings.TrimSpace(content[1:]))
+			if err != nil {
+				return nil, err
+			}
+			node.Kind = handlebarsBlockNode
+			appendNode(node)
+			stack = append(stack, node)
+			inverse = append(inverse, content[0] == '^')
+			chained = append(chained, false)
+
+		case content == "else" || content == "^":
+			if len(stack) == 1 {
+				return nil, tag.errorf("`{{%s}}` used outside of a block", content)
+			}
+			if inverse[len(inverse)-1] {
+				return nil, tag.errorf("block `%s` has more than one `{{else}}` section", stack[len(stack)-1].Name)
+			}
+			inverse[len(inverse)-1] = true
+
+		case strings.HasPrefix(content, "else "):
+			if len(stack) == 1 {
+				return nil, tag.errorf("`{{%s}}` used outside of a block", content)
+			}
+			if inverse[len(inverse)-1] {
+				return nil, tag.errorf("block `%s` has more than one `{{else}}` section", stack[len(stack)-1].Name)
+			}
+			inverse[len(inverse)-1] = true
+
+			node, err := parseHandlebarsExpression(tag, strings.TrimSpace(strings.TrimPrefix(content, "else ")))
+			if err != nil {
+				return nil, err
+			}
+			node.Kind = handlebarsBlockNode
+			appendNode(node)
+			stack = append(stack, node)
+			inverse = append(inverse, false)
+			chained = append(chained, true)
+
+		case content[0] == '/':
+			name := strings.TrimSpace(content[1:])
+			for len(stack) > 1 && chained[len(chained)-1] {
+				stack = stack[:len(stack)-1]
+				inverse = inverse[:len(inverse)-1]
+				chained = chained[:len(chained)-1]
+			}
+			if len(stack) == 1 {
+				return nil, tag.errorf("closing block `%s` has no opening block", name)
+			}
+			open := stack[len(stack)-1]
+			if open.Name != name {
+				return nil, tag.errorf("closing block `%s` does not match opening block `%s` at line %d, column %d", name, open.Name, open.Line, open.Column)
+			}
+			stack = stack[:len(stack)-1]
+			inverse = inverse[:len(inverse)-1]
+			chained = chained[:len(chained)-1]
+
+		default:
+			node, err := parseHandlebarsExpression(tag, content)
+			if err != nil {
+				return nil, err
+			}
+			node.Kind = handlebarsMustacheNode
+			node.Raw = tag.raw
+			appendNode(node)
+		}
+	}
+
+	for len(stack) > 1 {
+		open := stack[len(stack)-1]
+		if chained[len(chained)-1] {
+			stack = stack[:len(stack)-1]
+			chained = chained[:len(chained)-1]
+			continue
+		}
+		return nil, &handlebarsSyntaxError{Line: open.Line, Column: open.Column, Message: fmt.Sprintf("block `%s` is never closed", open.Name)}
+	}
+
+	return root.Children, nil
+}
+
+// Reads the mustache starting at `start`, returning its trimmed content
+// and the position immediately after it.
+func readHandlebarsTag(template string, start int) (*handlebarsTag, int, error) {
+	line, column := handlebarsPosition(template, start)
+	tag := &handlebarsTag{line: line, column: column}
+
+	open, close := "{{", "}}"
+	if strings.HasPrefix(template[start:], "{{{") {
+		open, close = "{{{", "}}}"
+		tag.raw = true
+	}
+
+	body := start + len(open)
+
+	// Long comments may contain mustaches, so they are terminated by `--}}`.
+	if strings.HasPrefix(strings.TrimPrefix(template[body:], "~"), "!--") {
+		end := strings.Index(template[body:], "--}}")
+		if end < 0 {
+			end = strings.Index(template[body:], "--~}}")
+			if end < 0 {
+				return nil, 0, tag.errorf("comment is never closed")
+			}
+			tag.content = "!"
+			return tag, body + end + len("--~}}"), nil
+		}
+		tag.content = "!"
+		return tag, body + end + len("--}}"), nil
+	}
+
+	end := strings.Index(template[body:], close)
+	if end < 0 {
+		return nil, 0, tag.errorf("`%s` is never closed with `%s`", open, close)
+	}
+
+	content := template[body : body+end]
+	if strings.Contains(content, "{{") {
+		return nil, 0, tag.errorf("`%s` is never closed with `%s`", open, close)
+	}
+
+	// Whitespace control characters, e.g. `{{~foo~}}`, do not change the expression.
+	content = strings.TrimPrefix(content, "~")
+	content = strings.TrimSuffix(content, "~")
+	tag.content = strings.TrimSpace(content)
+
+	return tag, body + end + len(close), nil
+}
+
+func (t *handlebarsTag) errorf(format string, args ...interface{}) error {
+	return &handlebarsSyntaxError{Line: t.line, Column: t.column, Message: fmt.Sprintf(format, args...)}
+}
+
+// Splits an expression, e.g. `join tags.host separator=", "`, into a node
+// holding the helper or path name, its positional parameters and its hash.
+func parseHandlebarsExpression(tag *handlebarsTag, expression string) (*handlebarsNode, error) {
+	tokens, err := tokenizeHandlebarsExpression(expression)
+	if err != nil {
+		return nil, tag.errorf("%s in `%s`", err, expression)
+	}
+
+	if len(tokens) == 0 {
+		return nil, tag.errorf("empty expression")
+	}
+
+	node := &handlebarsNode{
+		Name:   tokens[0],
+		Line:   tag.line,
+		Column: tag.column,
+	}
+
+	if !isHandlebarsPath(node.Name) {
+		return nil, tag.errorf("`%s` is not a valid helper or variable name", node.Name)
+	}
+
+	for _, token := range tokens[1:] {
+		if key, value, ok := splitHandlebarsHashParam(token); ok {
+			if node.Hash == nil {
+				node.Hash = map[string]string{}
+			}
+			node.Hash[key] = value
+			continue
+		}
+
+		if len(node.Hash) > 0 {
+			return nil, tag.errorf("positional parameter `%s` follows hash parameters in `%s`", token, expression)
+		}
+
+		node.Params = append(node.Params, token)
+	}
+
+	return node, nil
+}
+
+// Splits an expression on whitespace, keeping quoted strings and
+// parenthesized subexpressions together.
+func tokenizeHandlebarsExpression(expression string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	var quote rune
+	depth := 0
+
+	for _, r := range expression {
+		switch {
+		case quote != 0:
+			current.WriteRune(r)
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			current.WriteRune(r)
+		case r == '(':
+			depth++
+			current.WriteRune(r)
+		case r == ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced `)`")
+			}
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && depth == 0:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated string")
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("unbalanced `(`")
+	}
+
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens, nil
+}
+
+func splitHandlebarsHashParam(token string) (string, string, bool) {
+	if strings.HasPrefix(token, "(") || strings.HasPrefix(token, "\"") || strings.HasPrefix(token, "'") {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(token, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+// Reports whether a token is a path such as `issueTitle`, `entity.name`, `../title`, `@index` or `tags.[host name]`.
+func isHandlebarsPath(token string) bool {
+	if token == "." || token == "this" {
+		return true
+	}
+
+	token = strings.TrimPrefix(token, "@")
+	for strings.HasPrefix(token, "../") {
+		token = strings.TrimPrefix(token, "../")
+	}
+
+	for _, segment := range splitHandlebarsPath(token) {
+		if segment == "" {
+			return false
+		}
+
+		if strings.HasPrefix(segment, "[") && strings.HasSuffix(segment, "]") {
+			continue
+		}
+
+		for _, r := range segment {
+			if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '$') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Splits a path on `.` and `/` separators, keeping bracketed segments together.
+func splitHandlebarsPath(path string) []string {
+	var segments []string
+	var current strings.Builder
+	bracketed := false
+
+	for _, r := range path {
+		switch {
+		case r == '[':
+			bracketed = true
+			current.WriteRune(r)
+		case r == ']':
+			bracketed = false
+			current.WriteRune(r)
+		case (r == '.' || r == '/') && !bracketed:
+			segments = append(segments, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	return append(segments, current.String())
+}
+
+// Reports whether a parameter is a literal rather than a path or subexpression.
+func isHandlebarsLiteral(token string) bool {
+	switch token {
+	case "true", "false", "null", "undefined":
+		return true
+	}
+
+	if strings.HasPrefix(token, "\"") || strings.HasPrefix(token, "'") {
+		return true
+	}
+
+	for i, r := range token {
+		if !(unicode.IsDigit(r) || r == '.' || (i == 0 && r == '-')) {
+			return false
+		}
+	}
+
+	return token != ""
+}
+
+// Returns the 1-based line and column of a byte offset within a template.
+func handlebarsPosition(template string, offset int) (int, int) {
+	line := 1 + strings.Count(template[:offset], "\n")
+	column := offset + 1
+	if i := strings.LastIndex(template[:offset], "\n"); i >= 0 {
+		column = offset - i
+	}
+
+	return line, column
+}
+
+// Walks the nodes of a parsed template depth-first. The `scoped` argument is true for nodes
+// nested in a block which changes the template context, e.g. `{{#each}}` or `{{#with}}`,
+// where paths cannot be resolved against the root variables.
+func walkHandlebarsNodes(nodes []*handlebarsNode, scoped bool, fn func(node *handlebarsNode, scoped bool) error) error {
+	for _, node := range nodes {
+		if node.Kind == handlebarsTextNode {
+			continue
+		}
+
+		if err := fn(node, scoped); err != nil {
+			return err
+		}
+
+		childScoped := scoped || node.Name == "each" || node.Name == "with"
+		if err := walkHandlebarsNodes(node.Children, childScoped, fn); err != nil {
+			return err
+		}
+
+		if err := walkHandlebarsNodes(node.Inverse, scoped, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the paths referenced by a node, i.e. its name when it is a plain lookup
+// and any positional or hash parameters which are neither literals nor subexpressions.
+func handlebarsNodePaths(node *handlebarsNode) []string {
+	var paths []string
+
+	if !node.IsHelperCall() {
+		return append(paths, node.Name)
+	}
+
+	params := append([]string{}, node.Params...)
+	for _, v := range node.Hash {
+		params = append(params, v)
+	}
+
+	for _, param := range params {
+		if isHandlebarsLiteral(param) || strings.HasPrefix(param, "(") {
+			continue
+		}
+		paths = append(paths, param)
+	}
+
+	return paths
+}
+
+// Reports whether a path refers to the current context or to data variables,
+// e.g. `this`, `../title` or `@index`, rather than to a root variable.
+func isHandlebarsContextPath(path string) bool {
+	return path == "." || path == "this" || strings.HasPrefix(path, "this.") || strings.HasPrefix(path, "this/") ||
+		strings.HasPrefix(path, "../") || strings.HasPrefix(path, "@")
+}
+
+// Parses a subexpression parameter, e.g. `(eq priority "CRITICAL")`, of a node.
+func parseHandlebarsSubexpression(node *handlebarsNode, param string) (*handlebarsNode, error) {
+	tag := &handlebarsTag{line: node.Line, column: node.Column}
+	expression := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(param, "("), ")"))
+
+	sub, err := parseHandlebarsExpression(tag, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sub.Params) == 0 && len(sub.Hash) == 0 {
+		return nil, tag.errorf("subexpression `%s` does not call a helper", param)
+	}
+
+	return sub, nil
+}
+
+// Returns the helper names called by a node, including the ones of its subexpressions.
+func handlebarsNodeHelpers(node *handlebarsNode) ([]string, error) {
+	var helpers []string
+
+	if node.IsHelperCall() {
+		helpers = append(helpers, node.Name)
+	}
+
+	params := append([]string{}, node.Params...)
+	for _, v := range node.Hash {
+		params = append(params, v)
+	}
+
+	for _, param := range params {
+		if !strings.HasPrefix(param, "(") {
+			continue
+		}
+
+		sub, err := parseHandlebarsSubexpression(node, param)
+		if err != nil {
+			return nil, err
+		}
+
+		subHelpers, err := handlebarsNodeHelpers(sub)
+		if err != nil {
+			return nil, err
+		}
+		helpers = append(helpers, subHelpers...)
+	}
+
+	return helpers, nil
+}
+
+// handlebarsScope is the context a template is rendered against, along with the
+// data variables, e.g. `@index`, of the enclosing `{{#each}}` block.
+type handlebarsScope struct {
+	context interface{}
+	data    map[string]interface{}
+	parent  *handlebarsScope
+}
+
+// Renders a parsed template against a context such as a sample issue. The built-in block
+// helpers, the comparison helpers and the `json` helper are evaluated, other helpers render
+// as empty strings since their output is only known once a notification is sent.
+func renderHandlebarsTemplate(nodes []*handlebarsNode, context interface{}) (string, error) {
+	var out strings.Builder
+
+	if err := renderHandlebarsNodes(&out, nodes, &handlebarsScope{context: context}); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+func renderHandlebarsNodes(out *strings.Builder, nodes []*handlebarsNode, scope *handlebarsScope) error {
+	for _, node := range nodes {
+		switch node.Kind {
+		case handlebarsTextNode:
+			out.WriteString(node.Text)
+
+		case handlebarsMustacheNode:
+			value, err := evaluateHandlebarsNode(node, scope)
+			if err != nil {
+				return err
+			}
+			out.WriteString(formatHandlebarsValue(value))
+
+		case handlebarsBlockNode:
+			if err := renderHandlebarsBlock(out, node, scope); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func renderHandlebarsBlock(out *strings.Builder, node *handlebarsNode, scope *handlebarsScope) error {
+	args, err := evaluateHandlebarsParams(node, scope)
+	if err != nil {
+		return err
+	}
+
+	var arg interface{}
+	if len(args) > 0 {
+		arg = args[0]
+	}
+
+	switch node.Name {
+	case "each":
+		var items []interface{}
+		var keys []string
+
+		switch v := arg.(type) {
+		case []interface{}:
+			items = v
+		case map[string]interface{}:
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				items = append(items, v[k])
+			}
+		}
+
+		if len(items) == 0 {
+			return renderHandlebarsNodes(out, node.Inverse, scope)
+		}
+
+		for i, item := range items {
+			data := map[string]interface{}{
+				"index": float64(i),
+				"first": i == 0,
+				"last":  i == len(items)-1,
+			}
+			if keys != nil {
+				data["key"] = keys[i]
+			}
+
+			if err := renderHandlebarsNodes(out, node.Children, &handlebarsScope{context: item, data: data, parent: scope}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	case "with":
+		if !isHandlebarsTruthy(arg) {
+			return renderHandlebarsNodes(out, node.Inverse, scope)
+		}
+
+		return renderHandlebarsNodes(out, node.Children, &handlebarsScope{context: arg, parent: scope})
+
+	case "unless":
+		if isHandlebarsTruthy(arg) {
+			return renderHandlebarsNodes(out, node.Inverse, scope)
+		}
+
+		return renderHandlebarsNodes(out, node.Children, scope)
+
+	case "if":
+		// The condition is the first parameter.
+	default:
+		arg, err = callHandlebarsHelper(node.Name, args)
+		if err != nil {
+			return err
+		}
+	}
+
+	if isHandlebarsTruthy(arg) {
+		return renderHandlebarsNodes(out, node.Children, scope)
+	}
+
+	return renderHandlebarsNodes(out, node.Inverse, scope)
+}
+
+// Evaluates a mustache or subexpression, i.e. either a helper call or a path lookup.
+func evaluateHandlebarsNode(node *handlebarsNode, scope *handlebarsScope) (interface{}, error) {
+	if !node.IsHelperCall() {
+		return resolveHandlebarsParam(node, node.Name, scope)
+	}
+
+	args, err := evaluateHandlebarsParams(node, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return callHandlebarsHelper(node.Name, args)
+}
+
+func evaluateHandlebarsParams(node *handlebarsNode, scope *handlebarsScope) ([]interface{}, error) {
+	args := make([]interface{}, 0, len(node.Params))
+
+	for _, param := range node.Params {
+		value, err := resolveHandlebarsParam(node, param, scope)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+	}
+
+	return args, nil
+}
+
+func callHandlebarsHelper(name string, args []interface{}) (interface{}, error) {
+	arg := func(i int) interface{} {
+		if i < len(args) {
+			return args[i]
+		}
+		return nil
+	}
+
+	switch name {
+	case "json":
+		b, err := json.Marshal(arg(0))
+		if err != nil {
+			return nil, err
+		}
+		return handlebarsSafeString(b), nil
+	case "eq":
+		return formatHandlebarsValue(arg(0)) == formatHandlebarsValue(arg(1)), nil
+	case "ne":
+		return formatHandlebarsValue(arg(0)) != formatHandlebarsValue(arg(1)), nil
+	case "lt", "lte", "gt", "gte":
+		a, _ := strconv.ParseFloat(formatHandlebarsValue(arg(0)), 64)
+		b, _ := strconv.ParseFloat(formatHandlebarsValue(arg(1)), 64)
+		return map[string]bool{"lt": a < b, "lte": a <= b, "gt": a > b, "gte": a >= b}[name], nil
+	case "and":
+		for _, a := range args {
+			if !isHandlebarsTruthy(a) {
+				return false, nil
+			}
+		}
+		return len(args) > 0, nil
+	case "or":
+		for _, a := range args {
+			if isHandlebarsTruthy(a) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "not":
+		return !isHandlebarsTruthy(arg(0)), nil
+	case "includes":
+		if list, ok := arg(0).([]interface{}); ok {
+			for _, item := range list {
+				if formatHandlebarsValue(item) == formatHandlebarsValue(arg(1)) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		return strings.Contains(formatHandlebarsValue(arg(0)), formatHandlebarsValue(arg(1))), nil
+	}
+
+	return "", nil
+}
+
+// Resolves a parameter, i.e. a literal, a subexpression or a path relative to the scope.
+func resolveHandlebarsParam(node *handlebarsNode, param string, scope *handlebarsScope) (interface{}, error) {
+	if strings.HasPrefix(param, "(") {
+		sub, err := parseHandlebarsSubexpression(node, param)
+		if err != nil {
+			return nil, err
+		}
+		return evaluateHandlebarsNode(sub, scope)
+	}
+
+	if isHandlebarsLiteral(param) {
+		switch param {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null", "undefined":
+			return nil, nil
+		}
+
+		if strings.HasPrefix(param, "\"") || strings.HasPrefix(param, "'") {
+			return param[1 : len(param)-1], nil
+		}
+
+		return strconv.ParseFloat(param, 64)
+	}
+
+	if strings.HasPrefix(param, "@") {
+		for s := scope; s != nil; s = s.parent {
+			if s.data != nil {
+				segments := splitHandlebarsPath(strings.TrimPrefix(param, "@"))
+				return lookupHandlebarsPath(s.data[segments[0]], segments[1:]), nil
+			}
+		}
+		return nil, nil
+	}
+
+	for strings.HasPrefix(param, "../") {
+		param = strings.TrimPrefix(param, "../")
+		if scope.parent != nil {
+			scope = scope.parent
+		}
+	}
+
+	if param == "." || param == "this" {
+		return scope.context, nil
+	}
+
+	param = strings.TrimPrefix(strings.TrimPrefix(param, "this."), "this/")
+
+	return lookupHandlebarsPath(scope.context, splitHandlebarsPath(param)), nil
+}
+
+func lookupHandlebarsPath(value interface{}, segments []string) interface{} {
+	for _, segment := range segments {
+		segment = strings.TrimSuffix(strings.TrimPrefix(segment, "["), "]")
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			value = v[segment]
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil
+			}
+			value = v[i]
+		default:
+			return nil
+		}
+	}
+
+	return value
+}
+
+// handlebarsSafeString is the output of a helper which is rendered as is.
+type handlebarsSafeString string
+
+func formatHandlebarsValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case handlebarsSafeString:
+		return string(v)
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, formatHandlebarsValue(item))
+		}
+		return strings.Join(items, ",")
+	case map[string]interface{}:
+		return "[object Object]"
+	}
+
+	return fmt.Sprint(value)
+}
+
+func isHandlebarsTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case handlebarsSafeString:
+		return v != ""
+	case float64:
+		return v != 0
+	case []interface{}:
+		return len(v) > 0
+	}
+
+	return true
+}
@@ -0,0 +1,92 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHandlebarsTemplate(t *testing.T) {
+	nodes, err := parseHandlebarsTemplate(`{"title": {{json issueTitle}}, {{! ignored }}"hosts": [{{#each entities}}"{{name}}"{{#unless @last}},{{/unless}}{{/each}}], "state": "{{#if acknowledged}}ack{{else if closed}}closed{{else}}open{{/if}}"}`)
+	require.NoError(t, err)
+
+	var names []string
+	err = walkHandlebarsNodes(nodes, false, func(node *handlebarsNode, scoped bool) error {
+		names = append(names, node.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"json", "each", "name", "unless", "if", "if"}, names)
+
+	require.Equal(t, handlebarsMustacheNode, nodes[1].Kind)
+	require.Equal(t, []string{"issueTitle"}, nodes[1].Params)
+	require.Equal(t, handlebarsBlockNode, nodes[4].Kind)
+	require.Equal(t, []string{"entities"}, nodes[4].Params)
+}
+
+func TestParseHandlebarsTemplate_Expressions(t *testing.T) {
+	nodes, err := parseHandlebarsTemplate(`{{~join (lookup tags "host") separator=", " ~}} {{{raw}}} \{{literal}}`)
+	require.NoError(t, err)
+
+	require.Equal(t, "join", nodes[0].Name)
+	require.Equal(t, []string{`(lookup tags "host")`}, nodes[0].Params)
+	require.Equal(t, map[string]string{"separator": `", "`}, nodes[0].Hash)
+	require.True(t, nodes[2].Raw)
+	require.Equal(t, " {{literal}}", nodes[3].Text)
+}
+
+func TestParseHandlebarsTemplate_Errors(t *testing.T) {
+	cases := map[string]string{
+		"{{issueTitle":                       "invalid template at line 1, column 1: `{{` is never closed with `}}`",
+		"{{{issueTitle}}":                    "invalid template at line 1, column 1: `{{{` is never closed with `}}}`",
+		"{{}}":                               "invalid template at line 1, column 1: empty expression",
+		"a\n  {{/if}}":                       "invalid template at line 2, column 3: closing block `if` has no opening block",
+		"{{#each a}}{{#if b}}{{/each}}":      "invalid template at line 1, column 21: closing block `each` does not match opening block `if` at line 1, column 12",
+		"{{#if a}}{{else}}{{else}}{{/if}}":   "invalid template at line 1, column 18: block `if` has more than one `{{else}}` section",
+		"{{else}}":                           "invalid template at line 1, column 1: `{{else}}` used outside of a block",
+		"{{#with a}}":                        "invalid template at line 1, column 1: block `with` is never closed",
+		"{{> partial}}":                      "invalid template at line 1, column 1: partials are not supported",
+		`{{join a separator=", }}`:           "invalid template at line 1, column 1: unterminated string in `join a separator=\",`",
+		"{{join (lookup a}}":                 "invalid template at line 1, column 1: unbalanced `(` in `join (lookup a`",
+		"{{join a=b c}}":                     "invalid template at line 1, column 1: positional parameter `c` follows hash parameters in `join a=b c`",
+		"{{issue-title!}}":                   "invalid template at line 1, column 1: `issue-title!` is not a valid helper or variable name",
+		"{{!-- unterminated {{comment}} --}": "invalid template at line 1, column 1: comment is never closed",
+	}
+
+	for template, expected := range cases {
+		_, err := parseHandlebarsTemplate(template)
+		require.Error(t, err, template)
+		require.Equal(t, expected, err.Error(), template)
+	}
+}
+
+func TestRenderHandlebarsTemplate(t *testing.T) {
+	context := map[string]interface{}{
+		"title":    "Disk \"full\"",
+		"priority": "CRITICAL",
+		"count":    float64(3),
+		"hosts":    []interface{}{"a", "b"},
+		"entity":   map[string]interface{}{"name": "app"},
+	}
+
+	cases := map[string]string{
+		`{"title": {{json title}}, "count": {{count}}}`:                                  `{"title": "Disk \"full\"", "count": 3}`,
+		`[{{#each hosts}}"{{this}}-{{@index}}"{{#unless @last}},{{/unless}}{{/each}}]`:   `["a-0","b-1"]`,
+		`{{#if (eq priority "CRITICAL")}}page{{else if missing}}skip{{else}}mail{{/if}}`: `page`,
+		`{{#eq count 2}}two{{else}}other{{/eq}}`:                                         `other`,
+		`{{#with entity}}{{name}} of {{../priority}}{{/with}}`:                           `app of CRITICAL`,
+		`{{#each missing}}x{{else}}none{{/each}} {{hosts}} {{translateTimestamp count}}`: `none a,b `,
+	}
+
+	for template, expected := range cases {
+		nodes, err := parseHandlebarsTemplate(template)
+		require.NoError(t, err, template)
+
+		rendered, err := renderHandlebarsTemplate(nodes, context)
+		require.NoError(t, err, template)
+		require.Equal(t, expected, rendered, template)
+	}
+}
@@ -159,3 +159,29 @@ func revertEscapedSingleQuote(name string) string {
 
 	return name
 }
+
+// Returns the values of an attribute of a sample issue or incident. Attributes holding
+// several values, e.g. `labels.policyIds`, are given as JSON arrays.
+func parseSampleAttributeValues(value string) []string {
+	if !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		return []string{value}
+	}
+
+	var items []interface{}
+	if err := json.Unmarshal([]byte(value), &items); err != nil {
+		return []string{value}
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			values = append(values, v)
+		default:
+			b, _ := json.Marshal(v)
+			values = append(values, string(b))
+		}
+	}
+
+	return values
+}
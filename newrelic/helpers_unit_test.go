@@ -4,6 +4,9 @@
 package newrelic
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -112,3 +115,101 @@ func TestToJSON(t *testing.T) {
 
 	require.Contains(t, result, "test")
 }
+
+func TestParseEntityGUID(t *testing.T) {
+	guid, err := parseEntityGUID(getConditionEntityGUID(67890, 12345))
+	require.NoError(t, err)
+	require.Equal(t, 12345, guid.AccountID)
+	require.Equal(t, "AIOPS", guid.Domain)
+	require.Equal(t, "CONDITION", guid.Type)
+	require.Equal(t, "67890", guid.Identifier)
+
+	// Padded GUIDs are accepted as well
+	guid, err = parseEntityGUID("MTIzNDV8QUlPUFN8V09SS0ZMT1d8YWJjLTEyMw==")
+	require.NoError(t, err)
+	require.Equal(t, "WORKFLOW", guid.Type)
+	require.Equal(t, "abc-123", guid.Identifier)
+
+	for _, invalid := range []string{"", "12345:67890", "Zm9vYmFy", "Zm9vfEFJT1BTfENPTkRJVElPTnwx", "MTIzNDV8QUlPUFN8Q09ORElUSU9OfA"} {
+		_, err = parseEntityGUID(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestResourceImportStateWithEntityGUID(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_id": {Type: schema.TypeInt, Optional: true},
+		},
+	}
+
+	resolve := func(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+		if guid.Identifier == "0" {
+			return errors.New("not found")
+		}
+
+		d.SetId("1:" + guid.Identifier)
+		return d.Set("account_id", guid.AccountID)
+	}
+	importer := resourceImportStateWithEntityGUID("AIOPS", "CONDITION", resolve, nil)
+
+	cases := map[string]struct {
+		ID          string
+		ExpectedID  string
+		ExpectedErr bool
+	}{
+		"condition GUID":       {ID: getConditionEntityGUID(67890, 12345), ExpectedID: "1:67890"},
+		"composite ID":         {ID: "1:67890", ExpectedID: "1:67890"},
+		"other entity type":    {ID: "MTIzNDV8QUlPUFN8V09SS0ZMT1d8YWJjLTEyMw", ExpectedErr: true},
+		"failed resolution":    {ID: getConditionEntityGUID(0, 12345), ExpectedErr: true},
+		"non GUID passthrough": {ID: "abc-123", ExpectedID: "abc-123"},
+	}
+
+	for name, tc := range cases {
+		d := r.TestResourceData()
+		d.SetId(tc.ID)
+
+		result, err := importer(context.Background(), d, nil)
+		if tc.ExpectedErr {
+			require.Error(t, err, name)
+			continue
+		}
+
+		require.NoError(t, err, name)
+		require.Len(t, result, 1, name)
+		require.Equal(t, tc.ExpectedID, result[0].Id(), name)
+	}
+}
+
+func TestResourceImportStateWithEntityGUID_EntityTypes(t *testing.T) {
+	cases := map[string]struct {
+		Resource *schema.Resource
+		Expected string
+	}{
+		"newrelic_alert_condition":                          {Resource: resourceNewRelicAlertCondition(), Expected: "AIOPS|CONDITION"},
+		"newrelic_synthetics_alert_condition":               {Resource: resourceNewRelicSyntheticsAlertCondition(), Expected: "AIOPS|CONDITION"},
+		"newrelic_synthetics_multilocation_alert_condition": {Resource: resourceNewRelicSyntheticsMultiLocationAlertCondition(), Expected: "AIOPS|CONDITION"},
+		"newrelic_service_level":                            {Resource: resourceNewRelicServiceLevel(), Expected: "EXT|SERVICE_LEVEL"},
+		"newrelic_workflow":                                 {Resource: resourceNewRelicWorkflow(), Expected: "AIOPS|WORKFLOW"},
+	}
+
+	// 12345|NR1|WORKLOAD|67890
+	workloadGUID := "MTIzNDV8TlIxfFdPUktMT0FEfDY3ODkw"
+
+	for name, tc := range cases {
+		// The GUID of another entity type is rejected before reaching the API
+		d := tc.Resource.TestResourceData()
+		d.SetId(workloadGUID)
+
+		_, err := tc.Resource.Importer.StateContext(context.Background(), d, nil)
+		require.EqualError(t, err, fmt.Sprintf("entity GUID `%s` identifies a NR1|WORKLOAD entity, expected %s", workloadGUID, tc.Expected), name)
+
+		// Composite IDs are passed through
+		d = tc.Resource.TestResourceData()
+		d.SetId("12345:67890")
+
+		result, err := tc.Resource.Importer.StateContext(context.Background(), d, nil)
+		require.NoError(t, err, name)
+		require.Equal(t, "12345:67890", result[0].Id(), name)
+	}
+}
@@ -0,0 +1,659 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	nr "github.com/newrelic/newrelic-client-go/v2/newrelic"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// AlertChannelMigrationOptions configures MigrateAlertChannels.
+type AlertChannelMigrationOptions struct {
+	// StatePath is the path of a Terraform state file to read the legacy alert channels
+	// and policy channels from. The alert channels of the account are read when empty.
+	StatePath string
+
+	// AccountID and PersonalAPIKey give access to the account. They are optional when
+	// reading a state file, in which case no `import` blocks are generated.
+	AccountID      int
+	PersonalAPIKey string
+	Region         string
+}
+
+// The payload of the webhook channels replacing legacy webhook channels. Legacy payloads
+// use `$VARIABLE` substitutions, which notification channels don't support.
+const migratedWebhookPayload = `{
+  "id": {{ json issueId }},
+  "issueUrl": {{ json issuePageUrl }},
+  "title": {{ json annotations.title.[0] }},
+  "priority": {{ json priority }},
+  "state": {{ json state }},
+  "policyName": {{ json accumulations.policyName.[0] }},
+  "conditionName": {{ json accumulations.conditionName.[0] }}
+}`
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+type legacyAlertChannel struct {
+	ResourceName string
+	Channel      *alerts.Channel
+}
+
+type notificationPropertyMigration struct {
+	Key   string
+	Value string
+}
+
+type notificationDestinationMigration struct {
+	Type              string
+	Properties        []notificationPropertyMigration
+	AuthBasicUser     string
+	AuthBasicPassword string
+	AuthTokenPrefix   string
+	AuthToken         string
+}
+
+type notificationChannelMigration struct {
+	Type       string
+	Properties []notificationPropertyMigration
+}
+
+// alertChannelMigration holds the resources replacing a single legacy alert channel.
+type alertChannelMigration struct {
+	Legacy      *legacyAlertChannel
+	Destination *notificationDestinationMigration
+	Channel     *notificationChannelMigration
+	Notes       []string
+
+	// Sensitive values which can't be read back are replaced by variables.
+	Variables map[string]string
+}
+
+// MigrateAlertChannels writes the notification destinations, notification channels and
+// workflows which replace the legacy alert channels and policy channels, as HCL. Each
+// legacy channel becomes a destination and a channel, and each policy with channels
+// becomes a workflow notifying the channels of the policy. When the account is accessible,
+// `import` blocks are written for the replacements which already exist in the account.
+func MigrateAlertChannels(ctx context.Context, opts AlertChannelMigrationOptions, w io.Writer) error {
+	var client *nr.NewRelic
+	if opts.PersonalAPIKey != "" {
+		c, err := newAlertChannelMigrationClient(opts)
+		if err != nil {
+			return err
+		}
+
+		client = c
+	}
+
+	var channels []*legacyAlertChannel
+	var policyNames map[int]string
+	var err error
+
+	if opts.StatePath != "" {
+		data, readErr := os.ReadFile(opts.StatePath)
+		if readErr != nil {
+			return readErr
+		}
+
+		channels, policyNames, err = readLegacyAlertChannelsFromState(data)
+	} else {
+		if client == nil || opts.AccountID == 0 {
+			return fmt.Errorf("an account ID and a personal API key are required to read the alert channels of an account")
+		}
+
+		channels, policyNames, err = readLegacyAlertChannelsFromAccount(ctx, client, opts.AccountID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	migrations := make([]*alertChannelMigration, len(channels))
+	for i, channel := range channels {
+		migrations[i] = migrateLegacyAlertChannel(channel)
+	}
+
+	file := buildAlertChannelMigrationHCL(migrations, policyNames)
+
+	if client != nil && opts.AccountID != 0 {
+		existing, err := findExistingAlertChannelReplacements(ctx, client, opts.AccountID)
+		if err != nil {
+			return err
+		}
+
+		appendAlertChannelMigrationImports(file.Body(), migrations, policyNames, existing)
+	} else {
+		appendHCLComment(file.Body(), "No account was provided, so no import blocks were generated for replacements which already exist.")
+	}
+
+	_, err = w.Write(file.Bytes())
+
+	return err
+}
+
+// newAlertChannelMigrationClient builds the client reading the account, which identifies itself
+// with the user agent of the provider.
+func newAlertChannelMigrationClient(opts AlertChannelMigrationOptions) (*nr.NewRelic, error) {
+	serviceName := getUserAgentServiceName()
+
+	cfg := Config{
+		PersonalAPIKey: opts.PersonalAPIKey,
+		Region:         opts.Region,
+		userAgent:      fmt.Sprintf("%s/%s", serviceName, ProviderVersion),
+		serviceName:    serviceName,
+	}
+
+	if cfg.Region == "" {
+		cfg.Region = "US"
+	}
+
+	return cfg.Client()
+}
+
+type terraformState struct {
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// Reads the legacy alert channels, the policies they are added to and the names of
+// those policies from the managed resources of a Terraform state file.
+func readLegacyAlertChannelsFromState(data []byte) ([]*legacyAlertChannel, map[int]string, error) {
+	var state terraformState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, nil, fmt.Errorf("error reading state file: %v", err)
+	}
+
+	var channels []*legacyAlertChannel
+	channelPolicies := map[int][]int{}
+	policyNames := map[int]string{}
+	resourceNames := map[string]bool{}
+
+	for _, r := range state.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+
+		for _, instance := range r.Instances {
+			attributes := instance.Attributes
+			id, _ := strconv.Atoi(fmt.Sprintf("%v", attributes["id"]))
+
+			switch r.Type {
+			case "newrelic_alert_channel":
+				d, err := resourceDataFromAttributes(resourceNewRelicAlertChannel(), attributes)
+				if err != nil {
+					return nil, nil, fmt.Errorf("error reading newrelic_alert_channel.%s: %v", r.Name, err)
+				}
+
+				channel, err := expandAlertChannel(d)
+				if err != nil {
+					return nil, nil, fmt.Errorf("error reading newrelic_alert_channel.%s: %v", r.Name, err)
+				}
+
+				channel.ID = id
+
+				resourceName := legacyAlertChannelStateName(r.Module, r.Name)
+				if instance.IndexKey != nil {
+					resourceName = fmt.Sprintf("%s_%v", resourceName, instance.IndexKey)
+				}
+
+				resourceName = terraformResourceName(resourceName)
+				if resourceNames[resourceName] {
+					resourceName = fmt.Sprintf("%s_%d", resourceName, id)
+				}
+				resourceNames[resourceName] = true
+
+				channels = append(channels, &legacyAlertChannel{
+					ResourceName: resourceName,
+					Channel:      channel,
+				})
+
+			case "newrelic_alert_policy_channel":
+				policyID := stateInt(attributes["policy_id"])
+				channelIDs, _ := attributes["channel_ids"].([]interface{})
+
+				for _, channelID := range channelIDs {
+					channelPolicies[stateInt(channelID)] = append(channelPolicies[stateInt(channelID)], policyID)
+				}
+
+			case "newrelic_alert_policy":
+				policyNames[id] = stateString(attributes["name"])
+			}
+		}
+	}
+
+	for _, channel := range channels {
+		channel.Channel.Links.PolicyIDs = channelPolicies[channel.Channel.ID]
+	}
+
+	return channels, policyNames, nil
+}
+
+// Channels of different modules may have the same name, they are prefixed by the names of their
+// modules, e.g. `team_a_email` for `module.team_a.newrelic_alert_channel.email`.
+func legacyAlertChannelStateName(module string, name string) string {
+	parts := []string{}
+	for _, part := range strings.Split(module, ".") {
+		if part != "" && part != "module" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(append(parts, name), "_")
+}
+
+// Reads the legacy alert channels of an account, along with the names of the policies they are added to.
+func readLegacyAlertChannelsFromAccount(ctx context.Context, client *nr.NewRelic, accountID int) ([]*legacyAlertChannel, map[int]string, error) {
+	list, err := client.Alerts.ListChannelsWithContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	channels := make([]*legacyAlertChannel, len(list))
+	policyNames := map[int]string{}
+	resourceNames := map[string]bool{}
+
+	for i, channel := range list {
+		resourceName := terraformResourceName(channel.Name)
+		if resourceNames[resourceName] {
+			resourceName = fmt.Sprintf("%s_%d", resourceName, channel.ID)
+		}
+		resourceNames[resourceName] = true
+
+		channels[i] = &legacyAlertChannel{
+			ResourceName: resourceName,
+			Channel:      channel,
+		}
+
+		for _, policyID := range channel.Links.PolicyIDs {
+			if _, ok := policyNames[policyID]; ok {
+				continue
+			}
+
+			policy, err := client.Alerts.QueryPolicyWithContext(ctx, accountID, strconv.Itoa(policyID))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			policyNames[policyID] = policy.Name
+		}
+	}
+
+	return channels, policyNames, nil
+}
+
+// Maps a legacy alert channel to the destination and channel replacing it.
+func migrateLegacyAlertChannel(legacy *legacyAlertChannel) *alertChannelMigration {
+	config := legacy.Channel.Configuration
+	migration := &alertChannelMigration{
+		Legacy:    legacy,
+		Variables: map[string]string{},
+	}
+
+	switch legacy.Channel.Type {
+	case alerts.ChannelTypes.Email:
+		migration.Destination = &notificationDestinationMigration{
+			Type:       string(notifications.AiNotificationsDestinationTypeTypes.EMAIL),
+			Properties: []notificationPropertyMigration{{Key: "email", Value: config.Recipients}},
+		}
+		migration.Channel = &notificationChannelMigration{
+			Type:       string(notifications.AiNotificationsChannelTypeTypes.EMAIL),
+			Properties: []notificationPropertyMigration{{Key: "subject", Value: "{{ issueTitle }}"}},
+		}
+
+		if includeJSON, _ := strconv.ParseBool(config.IncludeJSONAttachment); includeJSON {
+			migration.Notes = append(migration.Notes, "The JSON attachment of the legacy email channel has no equivalent.")
+		}
+
+	case alerts.ChannelTypes.Slack:
+		migration.Destination = &notificationDestinationMigration{
+			Type:       string(notifications.AiNotificationsDestinationTypeTypes.SLACK_LEGACY),
+			Properties: []notificationPropertyMigration{{Key: "url", Value: config.URL}},
+		}
+		migration.Channel = &notificationChannelMigration{
+			Type:       string(notifications.AiNotificationsChannelTypeTypes.SLACK_LEGACY),
+			Properties: []notificationPropertyMigration{{Key: "channel", Value: config.Channel}},
+		}
+
+		if config.URL == "" {
+			migration.Destination.Properties[0].Value = migration.variable("slack_url", "The Slack webhook URL")
+		}
+
+	case alerts.ChannelTypes.PagerDuty:
+		migration.Destination = &notificationDestinationMigration{
+			Type:            string(notifications.AiNotificationsDestinationTypeTypes.PAGERDUTY_SERVICE_INTEGRATION),
+			Properties:      []notificationPropertyMigration{{Key: "", Value: ""}},
+			AuthTokenPrefix: "Token token=",
+			AuthToken:       config.ServiceKey,
+		}
+		migration.Channel = &notificationChannelMigration{
+			Type:       string(notifications.AiNotificationsChannelTypeTypes.PAGERDUTY_SERVICE_INTEGRATION),
+			Properties: []notificationPropertyMigration{{Key: "summary", Value: "{{ annotations.title.[0] }}"}},
+		}
+
+		if config.ServiceKey == "" {
+			migration.Destination.AuthToken = migration.variable("pagerduty_service_key", "The PagerDuty service integration key")
+		}
+
+	case alerts.ChannelTypes.Webhook:
+		migration.Destination = &notificationDestinationMigration{
+			Type:       string(notifications.AiNotificationsDestinationTypeTypes.WEBHOOK),
+			Properties: []notificationPropertyMigration{{Key: "url", Value: config.BaseURL}},
+		}
+		migration.Channel = &notificationChannelMigration{
+			Type:       string(notifications.AiNotificationsChannelTypeTypes.WEBHOOK),
+			Properties: []notificationPropertyMigration{{Key: "payload", Value: migratedWebhookPayload}},
+		}
+
+		if config.AuthUsername != "" {
+			migration.Destination.AuthBasicUser = config.AuthUsername
+			migration.Destination.AuthBasicPassword = config.AuthPassword
+
+			if config.AuthPassword == "" {
+				migration.Destination.AuthBasicPassword = migration.variable("webhook_password", "The webhook basic authentication password")
+			}
+		}
+
+		if len(config.Payload) > 0 {
+			migration.Notes = append(migration.Notes, "The custom payload of the legacy webhook channel uses $-variables and must be ported to a Handlebars template.")
+		}
+
+		if len(config.Headers) > 0 {
+			migration.Notes = append(migration.Notes, "The custom headers of the legacy webhook channel are not migrated.")
+		}
+
+	default:
+		migration.Notes = append(migration.Notes, fmt.Sprintf("Legacy `%s` channels have no automatic migration.", legacy.Channel.Type))
+	}
+
+	return migration
+}
+
+// Registers a variable replacing a sensitive value and returns a reference to it.
+func (m *alertChannelMigration) variable(suffix string, description string) string {
+	name := fmt.Sprintf("%s_%s", m.Legacy.ResourceName, suffix)
+	m.Variables[name] = fmt.Sprintf("%s of the `%s` channel.", description, m.Legacy.Channel.Name)
+
+	return "${var." + name + "}"
+}
+
+func buildAlertChannelMigrationHCL(migrations []*alertChannelMigration, policyNames map[int]string) *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	policyChannels := map[int][]string{}
+	var variables []string
+	variableDescriptions := map[string]string{}
+
+	for _, m := range migrations {
+		for _, note := range m.Notes {
+			appendHCLComment(body, fmt.Sprintf("%s (id %d): %s", m.Legacy.Channel.Name, m.Legacy.Channel.ID, note))
+		}
+
+		if m.Destination == nil {
+			body.AppendNewline()
+			continue
+		}
+
+		destination := body.AppendNewBlock("resource", []string{"newrelic_notification_destination", m.Legacy.ResourceName}).Body()
+		destination.SetAttributeValue("name", cty.StringVal(m.Legacy.Channel.Name))
+		destination.SetAttributeValue("type", cty.StringVal(m.Destination.Type))
+		appendNotificationPropertyBlocks(destination, m.Destination.Properties)
+
+		if m.Destination.AuthBasicUser != "" {
+			auth := destination.AppendNewBlock("auth_basic", nil).Body()
+			auth.SetAttributeValue("user", cty.StringVal(m.Destination.AuthBasicUser))
+			setHCLStringOrReference(auth, "password", m.Destination.AuthBasicPassword)
+		}
+
+		if m.Destination.AuthToken != "" {
+			auth := destination.AppendNewBlock("auth_token", nil).Body()
+			auth.SetAttributeValue("prefix", cty.StringVal(m.Destination.AuthTokenPrefix))
+			setHCLStringOrReference(auth, "token", m.Destination.AuthToken)
+		}
+
+		body.AppendNewline()
+
+		channel := body.AppendNewBlock("resource", []string{"newrelic_notification_channel", m.Legacy.ResourceName}).Body()
+		channel.SetAttributeValue("name", cty.StringVal(m.Legacy.Channel.Name))
+		channel.SetAttributeValue("type", cty.StringVal(m.Channel.Type))
+		channel.SetAttributeTraversal("destination_id", resourceAttributeTraversal("newrelic_notification_destination", m.Legacy.ResourceName, "id"))
+		channel.SetAttributeValue("product", cty.StringVal(string(notifications.AiNotificationsProductTypes.IINT)))
+		appendNotificationPropertyBlocks(channel, m.Channel.Properties)
+
+		body.AppendNewline()
+
+		for _, policyID := range m.Legacy.Channel.Links.PolicyIDs {
+			policyChannels[policyID] = append(policyChannels[policyID], m.Legacy.ResourceName)
+		}
+
+		for name, description := range m.Variables {
+			variables = append(variables, name)
+			variableDescriptions[name] = description
+		}
+	}
+
+	for _, policyID := range sortedPolicyIDs(policyChannels) {
+		workflow := body.AppendNewBlock("resource", []string{"newrelic_workflow", migratedWorkflowResourceName(policyID)}).Body()
+		workflow.SetAttributeValue("name", cty.StringVal(migratedWorkflowName(policyID, policyNames)))
+		workflow.SetAttributeValue("muting_rules_handling", cty.StringVal(string(workflows.AiWorkflowsMutingRulesHandlingTypes.NOTIFY_ALL_ISSUES)))
+
+		filter := workflow.AppendNewBlock("issues_filter", nil).Body()
+		filter.SetAttributeValue("name", cty.StringVal(fmt.Sprintf("policy-%d", policyID)))
+		filter.SetAttributeValue("type", cty.StringVal(string(workflows.AiWorkflowsFilterTypeTypes.FILTER)))
+
+		predicate := filter.AppendNewBlock("predicate", nil).Body()
+		predicate.SetAttributeValue("attribute", cty.StringVal("labels.policyIds"))
+		predicate.SetAttributeValue("operator", cty.StringVal(string(workflows.AiWorkflowsOperatorTypes.EXACTLY_MATCHES)))
+		predicate.SetAttributeValue("values", cty.ListVal([]cty.Value{cty.StringVal(strconv.Itoa(policyID))}))
+
+		for _, resourceName := range policyChannels[policyID] {
+			destination := workflow.AppendNewBlock("destination", nil).Body()
+			destination.SetAttributeTraversal("channel_id", resourceAttributeTraversal("newrelic_notification_channel", resourceName, "id"))
+		}
+
+		body.AppendNewline()
+	}
+
+	sort.Strings(variables)
+	for _, name := range variables {
+		variable := body.AppendNewBlock("variable", []string{name}).Body()
+		variable.SetAttributeValue("description", cty.StringVal(variableDescriptions[name]))
+		variable.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		variable.SetAttributeValue("sensitive", cty.True)
+
+		body.AppendNewline()
+	}
+
+	return file
+}
+
+// alertChannelReplacements holds the IDs of the destinations, channels and workflows of
+// an account by name, and for destinations and channels, by type.
+type alertChannelReplacements struct {
+	Destinations map[string]string
+	Channels     map[string]string
+	Workflows    map[string]string
+}
+
+func findExistingAlertChannelReplacements(ctx context.Context, client *nr.NewRelic, accountID int) (*alertChannelReplacements, error) {
+	existing := &alertChannelReplacements{
+		Destinations: map[string]string{},
+		Channels:     map[string]string{},
+		Workflows:    map[string]string{},
+	}
+
+	updatedContext := updateContextWithAccountID(ctx, accountID)
+
+	for cursor, done := "", false; !done; {
+		resp, err := client.Notifications.GetDestinationsWithContext(updatedContext, accountID, cursor, ai.AiNotificationsDestinationFilter{}, notifications.AiNotificationsDestinationSorter{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, destination := range resp.Entities {
+			existing.Destinations[string(destination.Type)+"|"+destination.Name] = destination.ID
+		}
+
+		cursor, done = resp.NextCursor, resp.NextCursor == ""
+	}
+
+	for cursor, done := "", false; !done; {
+		resp, err := client.Notifications.GetChannelsWithContext(updatedContext, accountID, cursor, ai.AiNotificationsChannelFilter{}, notifications.AiNotificationsChannelSorter{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range resp.Entities {
+			existing.Channels[string(channel.Type)+"|"+channel.Name] = channel.ID
+		}
+
+		cursor, done = resp.NextCursor, resp.NextCursor == ""
+	}
+
+	for cursor, done := "", false; !done; {
+		resp, err := client.Workflows.GetWorkflowsWithContext(updatedContext, accountID, cursor, ai.AiWorkflowsFilters{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, workflow := range resp.Entities {
+			existing.Workflows[workflow.Name] = workflow.ID
+		}
+
+		cursor, done = resp.NextCursor, resp.NextCursor == ""
+	}
+
+	return existing, nil
+}
+
+func appendAlertChannelMigrationImports(body *hclwrite.Body, migrations []*alertChannelMigration, policyNames map[int]string, existing *alertChannelReplacements) {
+	policyIDs := map[int][]string{}
+
+	for _, m := range migrations {
+		if m.Destination == nil {
+			continue
+		}
+
+		if id, ok := existing.Destinations[m.Destination.Type+"|"+m.Legacy.Channel.Name]; ok {
+			appendImportBlock(body, "newrelic_notification_destination", m.Legacy.ResourceName, id)
+		}
+
+		if id, ok := existing.Channels[m.Channel.Type+"|"+m.Legacy.Channel.Name]; ok {
+			appendImportBlock(body, "newrelic_notification_channel", m.Legacy.ResourceName, id)
+		}
+
+		for _, policyID := range m.Legacy.Channel.Links.PolicyIDs {
+			policyIDs[policyID] = nil
+		}
+	}
+
+	for _, policyID := range sortedPolicyIDs(policyIDs) {
+		if id, ok := existing.Workflows[migratedWorkflowName(policyID, policyNames)]; ok {
+			appendImportBlock(body, "newrelic_workflow", migratedWorkflowResourceName(policyID), id)
+		}
+	}
+}
+
+func appendImportBlock(body *hclwrite.Body, resourceType string, resourceName string, id string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+	})
+	block.SetAttributeValue("id", cty.StringVal(id))
+
+	body.AppendNewline()
+}
+
+func appendNotificationPropertyBlocks(body *hclwrite.Body, properties []notificationPropertyMigration) {
+	for _, property := range properties {
+		block := body.AppendNewBlock("property", nil).Body()
+		block.SetAttributeValue("key", cty.StringVal(property.Key))
+		setHCLStringOrReference(block, "value", property.Value)
+	}
+}
+
+// Sets a string attribute, unless the value is a `${var.name}` reference to a variable.
+func setHCLStringOrReference(body *hclwrite.Body, name string, value string) {
+	if strings.HasPrefix(value, "${var.") && strings.HasSuffix(value, "}") {
+		body.SetAttributeTraversal(name, hcl.Traversal{
+			hcl.TraverseRoot{Name: "var"},
+			hcl.TraverseAttr{Name: strings.TrimSuffix(strings.TrimPrefix(value, "${var."), "}")},
+		})
+
+		return
+	}
+
+	body.SetAttributeValue(name, cty.StringVal(value))
+}
+
+func appendHCLComment(body *hclwrite.Body, comment string) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + comment + "\n")},
+	})
+}
+
+func resourceAttributeTraversal(resourceType string, resourceName string, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+func migratedWorkflowName(policyID int, policyNames map[int]string) string {
+	if name, ok := policyNames[policyID]; ok && name != "" {
+		return name
+	}
+
+	return fmt.Sprintf("policy-%d", policyID)
+}
+
+func migratedWorkflowResourceName(policyID int) string {
+	return fmt.Sprintf("policy_%d", policyID)
+}
+
+func sortedPolicyIDs(m map[int][]string) []int {
+	ids := make([]int, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	return ids
+}
+
+// Converts a name into a valid Terraform resource name.
+func terraformResourceName(name string) string {
+	resourceName := strings.Trim(invalidResourceNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+
+	if resourceName == "" || (resourceName[0] >= '0' && resourceName[0] <= '9') || resourceName[0] == '-' {
+		resourceName = "channel_" + resourceName
+	}
+
+	return resourceName
+}
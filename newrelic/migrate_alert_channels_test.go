@@ -0,0 +1,216 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/stretchr/testify/require"
+)
+
+var testLegacyAlertChannelsState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "newrelic_alert_policy",
+      "name": "ops",
+      "instances": [{"attributes": {"id": "111", "name": "Ops policy"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "newrelic_alert_channel",
+      "name": "oncall",
+      "instances": [{
+        "attributes": {
+          "id": "10",
+          "name": "On-call email",
+          "type": "email",
+          "config": [{"recipients": "oncall@example.com", "include_json_attachment": "true"}]
+        }
+      }]
+    },
+    {
+      "mode": "managed",
+      "type": "newrelic_alert_channel",
+      "name": "pager",
+      "instances": [{
+        "attributes": {
+          "id": "20",
+          "name": "PagerDuty",
+          "type": "pagerduty",
+          "config": [{"service_key": "abc123"}]
+        }
+      }]
+    },
+    {
+      "mode": "managed",
+      "type": "newrelic_alert_channel",
+      "name": "ops_genie",
+      "instances": [{
+        "attributes": {
+          "id": "30",
+          "name": "OpsGenie",
+          "type": "opsgenie",
+          "config": [{"api_key": "key", "recipients": "ops@example.com"}]
+        }
+      }]
+    },
+    {
+      "mode": "managed",
+      "type": "newrelic_alert_policy_channel",
+      "name": "ops",
+      "instances": [{"attributes": {"id": "111:10:20", "policy_id": 111, "channel_ids": [10, 20]}}]
+    },
+    {
+      "mode": "data",
+      "type": "newrelic_alert_channel",
+      "name": "existing",
+      "instances": [{"attributes": {"id": "40", "name": "Existing", "type": "email"}}]
+    }
+  ]
+}`
+
+func TestReadLegacyAlertChannelsFromState(t *testing.T) {
+	channels, policyNames, err := readLegacyAlertChannelsFromState([]byte(testLegacyAlertChannelsState))
+	require.NoError(t, err)
+
+	require.Len(t, channels, 3)
+	require.Equal(t, map[int]string{111: "Ops policy"}, policyNames)
+
+	require.Equal(t, "oncall", channels[0].ResourceName)
+	require.Equal(t, 10, channels[0].Channel.ID)
+	require.Equal(t, alerts.ChannelTypes.Email, channels[0].Channel.Type)
+	require.Equal(t, "oncall@example.com", channels[0].Channel.Configuration.Recipients)
+	require.Equal(t, []int{111}, channels[0].Channel.Links.PolicyIDs)
+
+	require.Equal(t, "abc123", channels[1].Channel.Configuration.ServiceKey)
+	require.Empty(t, channels[2].Channel.Links.PolicyIDs)
+}
+
+func TestMigrateLegacyAlertChannel(t *testing.T) {
+	webhook := migrateLegacyAlertChannel(&legacyAlertChannel{
+		ResourceName: "hook",
+		Channel: &alerts.Channel{
+			Name: "Hook",
+			Type: alerts.ChannelTypes.Webhook,
+			Configuration: alerts.ChannelConfiguration{
+				BaseURL:      "https://example.com/hook",
+				AuthUsername: "user",
+			},
+		},
+	})
+
+	require.Equal(t, "WEBHOOK", webhook.Destination.Type)
+	require.Equal(t, []notificationPropertyMigration{{Key: "url", Value: "https://example.com/hook"}}, webhook.Destination.Properties)
+	require.Equal(t, "user", webhook.Destination.AuthBasicUser)
+	require.Equal(t, "${var.hook_webhook_password}", webhook.Destination.AuthBasicPassword)
+	require.Contains(t, webhook.Variables, "hook_webhook_password")
+	require.Equal(t, "WEBHOOK", webhook.Channel.Type)
+
+	victorOps := migrateLegacyAlertChannel(&legacyAlertChannel{
+		ResourceName: "victor",
+		Channel:      &alerts.Channel{Name: "Victor", Type: alerts.ChannelTypes.VictorOps},
+	})
+
+	require.Nil(t, victorOps.Destination)
+	require.Equal(t, []string{"Legacy `victorops` channels have no automatic migration."}, victorOps.Notes)
+}
+
+func TestMigrateAlertChannels_State(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	require.NoError(t, os.WriteFile(statePath, []byte(testLegacyAlertChannelsState), 0600))
+
+	var out bytes.Buffer
+	err := MigrateAlertChannels(context.Background(), AlertChannelMigrationOptions{StatePath: statePath}, &out)
+	require.NoError(t, err)
+
+	hcl := out.String()
+	require.Contains(t, hcl, `resource "newrelic_notification_destination" "oncall" {`)
+	require.Contains(t, hcl, `  type = "EMAIL"`)
+	require.Contains(t, hcl, `    value = "oncall@example.com"`)
+	require.Contains(t, hcl, `resource "newrelic_notification_channel" "pager" {`)
+	require.Contains(t, hcl, `  destination_id = newrelic_notification_destination.pager.id`)
+	require.Contains(t, hcl, `    token  = "abc123"`)
+	require.Contains(t, hcl, `resource "newrelic_workflow" "policy_111" {`)
+	require.Contains(t, hcl, `  name                  = "Ops policy"`)
+	require.Contains(t, hcl, `      values    = ["111"]`)
+	require.Contains(t, hcl, `    channel_id = newrelic_notification_channel.oncall.id`)
+	require.Contains(t, hcl, `    channel_id = newrelic_notification_channel.pager.id`)
+	require.Contains(t, hcl, "# OpsGenie (id 30): Legacy `opsgenie` channels have no automatic migration.")
+	require.Contains(t, hcl, "# On-call email (id 10): The JSON attachment of the legacy email channel has no equivalent.")
+	require.NotContains(t, hcl, `"existing"`)
+	require.NotContains(t, hcl, "import {")
+}
+
+func TestTerraformResourceName(t *testing.T) {
+	cases := map[string]string{
+		"On-call Email":  "on-call_email",
+		"#ops / alerts!": "ops_alerts",
+		"24x7":           "channel_24x7",
+		"":               "channel_",
+	}
+
+	for name, expected := range cases {
+		require.Equal(t, expected, terraformResourceName(name), name)
+	}
+}
+
+func TestReadLegacyAlertChannelsFromState_Modules(t *testing.T) {
+	state := `{
+  "version": 4,
+  "resources": [
+    {
+      "module": "module.team_a",
+      "mode": "managed",
+      "type": "newrelic_alert_channel",
+      "name": "email",
+      "instances": [{"attributes": {"id": "10", "name": "Team A", "type": "email", "config": [{"recipients": "a@example.com"}]}}]
+    },
+    {
+      "module": "module.team_b.module.alerts",
+      "mode": "managed",
+      "type": "newrelic_alert_channel",
+      "name": "email",
+      "instances": [{"attributes": {"id": "20", "name": "Team B", "type": "email", "config": [{"recipients": "b@example.com"}]}}]
+    },
+    {
+      "mode": "managed",
+      "type": "newrelic_alert_channel",
+      "name": "team_a_email",
+      "instances": [{"attributes": {"id": "30", "name": "Root", "type": "email", "config": [{"recipients": "root@example.com"}]}}]
+    }
+  ]
+}`
+
+	channels, _, err := readLegacyAlertChannelsFromState([]byte(state))
+	require.NoError(t, err)
+
+	require.Len(t, channels, 3)
+	require.Equal(t, "team_a_email", channels[0].ResourceName)
+	require.Equal(t, "team_b_alerts_email", channels[1].ResourceName)
+	require.Equal(t, "team_a_email_30", channels[2].ResourceName)
+}
+
+func TestMigrateAlertChannels_StateWithAPIKey(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	require.NoError(t, os.WriteFile(statePath, []byte(testLegacyAlertChannelsState), 0600))
+
+	// The client is built without reaching the account, which is only read with an account ID
+	var out bytes.Buffer
+	err := MigrateAlertChannels(context.Background(), AlertChannelMigrationOptions{StatePath: statePath, PersonalAPIKey: "NRAK-TEST"}, &out)
+	require.NoError(t, err)
+	require.Contains(t, out.String(), `resource "newrelic_notification_destination" "oncall" {`)
+}
+
+func TestNewAlertChannelMigrationClient(t *testing.T) {
+	client, err := newAlertChannelMigrationClient(AlertChannelMigrationOptions{PersonalAPIKey: "NRAK-TEST", Region: "EU"})
+	require.NoError(t, err)
+	require.NotNil(t, client)
+}
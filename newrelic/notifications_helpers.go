@@ -1,11 +1,17 @@
 package newrelic
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
+	nr "github.com/newrelic/newrelic-client-go/v2/newrelic"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -46,6 +52,14 @@ func notificationsPropertySchema() *schema.Resource {
 	}
 }
 
+// The property schema of notification channels, whose values may be templates.
+func notificationChannelPropertySchema() *schema.Resource {
+	property := notificationsPropertySchema()
+	property.Schema["value"].ValidateDiagFunc = validateNotificationChannelTemplateDiagFunc(isNotificationChannelJSONTemplate)
+
+	return property
+}
+
 // Builds an array of typed notifications error interface based on the GraphQL `response.errors` array.
 func buildAiNotificationsErrors(errors []ai.AiNotificationsError) diag.Diagnostics {
 	var diagErrors diag.Diagnostics
@@ -110,6 +124,84 @@ func buildAiNotificationsResponseErrors(errors []notifications.AiNotificationsRe
 	return diagErrors
 }
 
+// The channel test mutation is issued directly against NerdGraph, since it is not
+// part of the notifications client.
+const testNotificationChannelMutation = `
+	mutation($accountId: Int!, $channelId: ID!) {
+		aiNotificationsTestChannelById(accountId: $accountId, channelId: $channelId) {
+			details
+			result
+			errors {
+				... on AiNotificationsResponseError {
+					description
+					type
+					details
+				}
+				... on AiNotificationsDataValidationError {
+					details
+					fields {
+						message
+						field
+					}
+				}
+			}
+		}
+	}`
+
+const notificationChannelTestResultSuccess = "SUCCESS"
+
+type notificationChannelTestResult struct {
+	Details string                    `json:"details"`
+	Result  string                    `json:"result"`
+	Errors  []ai.AiNotificationsError `json:"errors"`
+}
+
+type notificationChannelTestResponse struct {
+	AiNotificationsTestChannelByID *notificationChannelTestResult `json:"aiNotificationsTestChannelById"`
+}
+
+// Sends a test notification through a channel, returning the result of the test.
+func testNotificationChannel(ctx context.Context, client *nr.NewRelic, accountID int, channelID string) (*notificationChannelTestResult, error) {
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"channelId": channelID,
+	}
+
+	resp := notificationChannelTestResponse{}
+	if err := client.NerdGraph.QueryWithResponseAndContext(ctx, testNotificationChannelMutation, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	if resp.AiNotificationsTestChannelByID == nil {
+		return nil, fmt.Errorf("error testing notification channel %s: response was nil", channelID)
+	}
+
+	return resp.AiNotificationsTestChannelByID, nil
+}
+
+// Builds the diagnostics of a failed channel test. They are warnings, since the channel
+// itself was saved and should not be tainted by a delivery failure.
+func buildNotificationChannelTestDiagnostics(channelID string, result *notificationChannelTestResult) diag.Diagnostics {
+	if result.Result == notificationChannelTestResultSuccess {
+		return nil
+	}
+
+	diags := diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("test notification for channel %s failed with result `%s`", channelID, result.Result),
+			Detail:   result.Details,
+		},
+	}
+
+	for _, d := range buildAiNotificationsErrors(result.Errors) {
+		d.Severity = diag.Warning
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
 func createMonitoringProperty() notifications.AiNotificationsPropertyInput {
 	return notifications.AiNotificationsPropertyInput{
 		Key:   "source",
@@ -117,3 +209,422 @@ func createMonitoringProperty() notifications.AiNotificationsPropertyInput {
 		Label: "terraform-source-internal",
 	}
 }
+
+// The property marking channels and destinations managed by Terraform is sent by the provider
+// itself, it is left out of the `property` blocks so removing every configured block clears them.
+func withoutMonitoringProperty(properties []notifications.AiNotificationsProperty) []notifications.AiNotificationsProperty {
+	monitoring := createMonitoringProperty()
+	out := []notifications.AiNotificationsProperty{}
+	for _, p := range properties {
+		if p.Key != monitoring.Key || p.Label != monitoring.Label {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+// notificationsTypedField maps an attribute of a typed notifications block to a property key.
+// Fields without a key are not sent as properties and are handled by the resource itself.
+type notificationsTypedField struct {
+	Attribute   string
+	Key         string
+	Description string
+	Required    bool
+	Bool        bool
+	Sensitive   bool
+	Template    bool
+	JSON        bool
+}
+
+// notificationsTypedBlock describes a block which expands to the properties of the
+// destination or channel types it supports.
+type notificationsTypedBlock struct {
+	Name          string
+	Description   string
+	Types         []string
+	Fields        []notificationsTypedField
+	ConflictsWith []string
+}
+
+type notificationsTypedBlocks []notificationsTypedBlock
+
+func (blocks notificationsTypedBlocks) names() []string {
+	names := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		names = append(names, block.Name)
+	}
+
+	return names
+}
+
+func (blocks notificationsTypedBlocks) get(name string) notificationsTypedBlock {
+	for _, block := range blocks {
+		if block.Name == name {
+			return block
+		}
+	}
+
+	panic(fmt.Sprintf("unknown notifications block `%s`", name))
+}
+
+// schema returns the schema of the typed block with the given name.
+func (blocks notificationsTypedBlocks) schema(name string) *schema.Schema {
+	block := blocks.get(name)
+
+	fields := map[string]*schema.Schema{}
+	for _, f := range block.Fields {
+		field := &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    f.Required,
+			Optional:    !f.Required,
+			Sensitive:   f.Sensitive,
+			Description: f.Description,
+		}
+		if f.Bool {
+			field.Type = schema.TypeBool
+		}
+
+		if f.Template {
+			isJSON := f.JSON
+			field.ValidateDiagFunc = validateNotificationChannelTemplateDiagFunc(func(string) bool { return isJSON })
+		}
+
+		fields[f.Attribute] = field
+	}
+
+	conflictsWith := append([]string{}, block.ConflictsWith...)
+	for _, other := range blocks.names() {
+		if other != name {
+			conflictsWith = append(conflictsWith, other)
+		}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Description:   fmt.Sprintf("%s Only valid for the types: %s.", block.Description, strings.Join(block.Types, ", ")),
+		Elem:          &schema.Resource{Schema: fields},
+	}
+}
+
+// expandProperties returns the properties described by the configured typed block, if any.
+func (blocks notificationsTypedBlocks) expandProperties(d *schema.ResourceData) []notifications.AiNotificationsPropertyInput {
+	props := []notifications.AiNotificationsPropertyInput{}
+
+	for _, block := range blocks {
+		attr, ok := d.GetOk(block.Name)
+		if !ok {
+			continue
+		}
+
+		cfg, ok := attr.([]interface{})[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, f := range block.Fields {
+			if f.Key == "" {
+				continue
+			}
+
+			var value string
+			switch v := cfg[f.Attribute].(type) {
+			case bool:
+				value = strconv.FormatBool(v)
+			case string:
+				value = v
+			}
+
+			if value == "" && !f.Required {
+				continue
+			}
+
+			props = append(props, notifications.AiNotificationsPropertyInput{Key: f.Key, Value: value})
+		}
+	}
+
+	return props
+}
+
+// mergeProperties combines the free-form properties with the ones of the typed blocks,
+// the typed blocks taking precedence for the keys they define.
+func (blocks notificationsTypedBlocks) mergeProperties(d *schema.ResourceData, properties []notifications.AiNotificationsPropertyInput) []notifications.AiNotificationsPropertyInput {
+	typed := blocks.expandProperties(d)
+	if len(typed) == 0 {
+		return properties
+	}
+
+	keys := map[string]bool{}
+	for _, p := range typed {
+		keys[p.Key] = true
+	}
+
+	merged := []notifications.AiNotificationsPropertyInput{}
+	for _, p := range properties {
+		if !keys[p.Key] {
+			merged = append(merged, p)
+		}
+	}
+
+	return append(merged, typed...)
+}
+
+// flattenProperties sets the configured typed block from the given properties and returns
+// the properties which are not described by it.
+func (blocks notificationsTypedBlocks) flattenProperties(d *schema.ResourceData, properties []notifications.AiNotificationsProperty) ([]notifications.AiNotificationsProperty, error) {
+	consumed := map[string]bool{}
+
+	for _, block := range blocks {
+		if _, ok := d.GetOk(block.Name); !ok {
+			continue
+		}
+
+		cfg := map[string]interface{}{}
+		for _, f := range block.Fields {
+			if f.Key == "" {
+				cfg[f.Attribute] = d.Get(fmt.Sprintf("%s.0.%s", block.Name, f.Attribute))
+				continue
+			}
+
+			var value string
+			for _, p := range properties {
+				if p.Key == f.Key {
+					value = p.Value
+					consumed[p.Key] = true
+				}
+			}
+
+			if f.Bool {
+				cfg[f.Attribute], _ = strconv.ParseBool(value)
+			} else {
+				cfg[f.Attribute] = value
+			}
+		}
+
+		if err := d.Set(block.Name, []interface{}{cfg}); err != nil {
+			return nil, fmt.Errorf("[DEBUG] Error setting notification `%s`: %v", block.Name, err)
+		}
+	}
+
+	remaining := []notifications.AiNotificationsProperty{}
+	for _, p := range properties {
+		if !consumed[p.Key] {
+			remaining = append(remaining, p)
+		}
+	}
+
+	return remaining, nil
+}
+
+// customizeDiff validates that the configured typed block supports the resource type and
+// that its properties are not also set through the free-form `property` blocks.
+func (blocks notificationsTypedBlocks) customizeDiff(kind string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		for _, block := range blocks {
+			if _, ok := d.GetOk(block.Name); !ok {
+				continue
+			}
+
+			if d.NewValueKnown("type") {
+				resourceType := d.Get("type").(string)
+				if !stringInSlice(block.Types, resourceType) {
+					return fmt.Errorf("the `%s` block cannot be used with %s type `%s`, expected one of: %s", block.Name, kind, resourceType, strings.Join(block.Types, ", "))
+				}
+			}
+
+			for _, property := range configuredNotificationsProperties(d) {
+				for _, f := range block.Fields {
+					if f.Key != "" && f.Key == property.Key {
+						return fmt.Errorf("property `%s` is already set by the `%s.%s` attribute", property.Key, block.Name, f.Attribute)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// Returns the `property` blocks present in the configuration, ignoring the ones only present
+// in state. Values which are not known yet are left empty.
+func configuredNotificationsProperties(d *schema.ResourceDiff) []notifications.AiNotificationsPropertyInput {
+	properties := []notifications.AiNotificationsPropertyInput{}
+
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return properties
+	}
+
+	attr := config.GetAttr("property")
+	if !attr.IsKnown() || attr.IsNull() {
+		return properties
+	}
+
+	for it := attr.ElementIterator(); it.Next(); {
+		_, property := it.Element()
+
+		key := property.GetAttr("key")
+		if !key.IsKnown() || key.IsNull() {
+			continue
+		}
+
+		p := notifications.AiNotificationsPropertyInput{Key: key.AsString()}
+		if value := property.GetAttr("value"); value.IsKnown() && !value.IsNull() {
+			p.Value = value.AsString()
+		}
+
+		properties = append(properties, p)
+	}
+
+	return properties
+}
+
+// Helpers available to notification templates, in addition to the built-in block helpers.
+var notificationsTemplateHelpers = []string{
+	"and",
+	"eq",
+	"gt",
+	"gte",
+	"includes",
+	"json",
+	"lt",
+	"lte",
+	"ne",
+	"not",
+	"or",
+	"translateTimestamp",
+}
+
+// A sample issue, used to render the JSON templates of notification channels.
+var notificationsTemplateSampleIssue = map[string]interface{}{
+	"issueId":        "00000000-0000-0000-0000-000000000000",
+	"issueTitle":     "Sample issue",
+	"issuePageUrl":   "https://radar-api.service.newrelic.com/accounts/1/issues/00000000-0000-0000-0000-000000000000",
+	"issueUrl":       "https://radar-api.service.newrelic.com/accounts/1/issues/00000000-0000-0000-0000-000000000000",
+	"priority":       "CRITICAL",
+	"state":          "ACTIVATED",
+	"status":         "ACTIVATED",
+	"triggerEvent":   "INCIDENT_ADDED",
+	"isCorrelated":   false,
+	"totalIncidents": float64(1),
+	"createdAt":      float64(1672531200000),
+	"updatedAt":      float64(1672531200000),
+	"activatedAt":    float64(1672531200000),
+	"workflowName":   "Sample workflow",
+	"accumulations": map[string]interface{}{
+		"conditionName":     []interface{}{"Sample condition"},
+		"policyName":        []interface{}{"Sample policy"},
+		"runbookUrl":        []interface{}{"https://example.com/runbook"},
+		"conditionFamilyId": []interface{}{"1"},
+		"source":            []interface{}{"newrelic"},
+		"tag": map[string]interface{}{
+			"host": []interface{}{"sample-host"},
+		},
+	},
+	"annotations": map[string]interface{}{
+		"title":       []interface{}{"Sample incident"},
+		"description": []interface{}{"Sample incident description"},
+	},
+	"entitiesData": map[string]interface{}{
+		"ids":   []interface{}{"MXxBUE18QVBQTElDQVRJT058MQ"},
+		"names": []interface{}{"Sample entity"},
+		"types": []interface{}{"APPLICATION"},
+	},
+	"labels": map[string]interface{}{
+		"accountIds": []interface{}{"1"},
+		"policyIds":  []interface{}{"1"},
+	},
+}
+
+// Validates a notification channel template. Malformed Handlebars is an error, while calls to
+// unknown helpers and JSON templates not rendering a valid JSON document for a sample issue are
+// returned as warnings, since the sample issue can't cover every field of the real issues.
+func validateNotificationChannelTemplate(value string, isJSON bool) ([]string, error) {
+	if strings.TrimSpace(value) == "" || (!isJSON && !strings.Contains(value, "{{")) {
+		return nil, nil
+	}
+
+	nodes, err := parseHandlebarsTemplate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := []string{}
+	err = walkHandlebarsNodes(nodes, false, func(node *handlebarsNode, scoped bool) error {
+		helpers, err := handlebarsNodeHelpers(node)
+		if err != nil {
+			return err
+		}
+
+		for _, helper := range helpers {
+			if !stringInSlice(handlebarsBuiltInBlockHelpers, helper) && !stringInSlice(notificationsTemplateHelpers, helper) {
+				warnings = append(warnings, fmt.Sprintf("unknown helper `%s` at line %d, column %d", helper, node.Line, node.Column))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !isJSON || len(warnings) > 0 {
+		return warnings, nil
+	}
+
+	rendered, err := renderHandlebarsTemplate(nodes, notificationsTemplateSampleIssue)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	var document interface{}
+	if err := json.Unmarshal([]byte(rendered), &document); err != nil {
+		return []string{fmt.Sprintf("the template does not render a valid JSON document for a sample issue: %s\n\n%s", err, rendered)}, nil
+	}
+
+	return nil, nil
+}
+
+// Property values are JSON templates when they start as a JSON object or array, rather than
+// with a Handlebars expression.
+func isNotificationChannelJSONTemplate(value string) bool {
+	value = strings.TrimSpace(value)
+
+	return strings.HasPrefix(value, "[") || (strings.HasPrefix(value, "{") && !strings.HasPrefix(value, "{{"))
+}
+
+// Returns the ValidateDiagFunc of the templated attributes of notification channels, reporting
+// the warnings of validateNotificationChannelTemplate in the plan.
+func validateNotificationChannelTemplateDiagFunc(isJSON func(value string) bool) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		value, ok := i.(string)
+		if !ok {
+			return diag.Errorf("expected type of %s to be string", i)
+		}
+
+		warnings, err := validateNotificationChannelTemplate(value, isJSON(value))
+		if err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "invalid notification channel template",
+				Detail:        err.Error(),
+				AttributePath: path,
+			}}
+		}
+
+		var diags diag.Diagnostics
+		for _, w := range warnings {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "notification channel template may not render as expected",
+				Detail:        w,
+				AttributePath: path,
+			})
+		}
+
+		return diags
+	}
+}
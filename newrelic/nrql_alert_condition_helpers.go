@@ -0,0 +1,186 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	nr "github.com/newrelic/newrelic-client-go/v2/newrelic"
+)
+
+// The NRQL condition mutations and queries below are issued directly against NerdGraph,
+// since the condition inputs of the alerts client do not include the fields
+// described by nrqlConditionExtendedFields.
+const (
+	createNrqlConditionBaselineMutation = `
+		mutation($accountId: Int!, $policyId: ID!, $condition: AlertsNrqlConditionBaselineInput!) {
+			alertsNrqlConditionBaselineCreate(accountId: $accountId, policyId: $policyId, condition: $condition) {
+				id
+			}
+		}`
+
+	createNrqlConditionStaticMutation = `
+		mutation($accountId: Int!, $policyId: ID!, $condition: AlertsNrqlConditionStaticInput!) {
+			alertsNrqlConditionStaticCreate(accountId: $accountId, policyId: $policyId, condition: $condition) {
+				id
+			}
+		}`
+
+	updateNrqlConditionBaselineMutation = `
+		mutation($accountId: Int!, $id: ID!, $condition: AlertsNrqlConditionUpdateBaselineInput!) {
+			alertsNrqlConditionBaselineUpdate(accountId: $accountId, id: $id, condition: $condition) {
+				id
+			}
+		}`
+
+	updateNrqlConditionStaticMutation = `
+		mutation($accountId: Int!, $id: ID!, $condition: AlertsNrqlConditionUpdateStaticInput!) {
+			alertsNrqlConditionStaticUpdate(accountId: $accountId, id: $id, condition: $condition) {
+				id
+			}
+		}`
+
+	getNrqlConditionExtendedFieldsQuery = `
+		query($accountId: Int!, $id: ID!) {
+			actor {
+				account(id: $accountId) {
+					alerts {
+						nrqlCondition(id: $id) {
+							titleTemplate
+							expiration {
+								ignoreOnExpectedTermination
+							}
+							terms {
+								priority
+								prediction {
+									predictBy
+									preferPredictionViolation
+								}
+							}
+							... on AlertsNrqlBaselineCondition {
+								signalSeasonality
+							}
+						}
+					}
+				}
+			}
+		}`
+)
+
+type nrqlConditionMutationResult struct {
+	ID string `json:"id"`
+}
+
+type nrqlConditionMutationResponse struct {
+	AlertsNrqlConditionBaselineCreate *nrqlConditionMutationResult `json:"alertsNrqlConditionBaselineCreate"`
+	AlertsNrqlConditionStaticCreate   *nrqlConditionMutationResult `json:"alertsNrqlConditionStaticCreate"`
+	AlertsNrqlConditionBaselineUpdate *nrqlConditionMutationResult `json:"alertsNrqlConditionBaselineUpdate"`
+	AlertsNrqlConditionStaticUpdate   *nrqlConditionMutationResult `json:"alertsNrqlConditionStaticUpdate"`
+}
+
+type nrqlConditionExtendedFieldsResponse struct {
+	Actor struct {
+		Account struct {
+			Alerts struct {
+				NrqlCondition *nrqlConditionExtendedFields `json:"nrqlCondition"`
+			} `json:"alerts"`
+		} `json:"account"`
+	} `json:"actor"`
+}
+
+// Creates a NRQL alert condition of the given type, including any extended fields,
+// and returns the ID of the new condition.
+func createNrqlCondition(
+	ctx context.Context,
+	client *nr.NewRelic,
+	accountID int,
+	policyID string,
+	conditionType string,
+	input interface{},
+	fields *nrqlConditionExtendedFields,
+) (int, error) {
+	condition, err := mergeNrqlConditionExtendedFields(input, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"policyId":  policyID,
+		"condition": condition,
+	}
+
+	resp := nrqlConditionMutationResponse{}
+
+	var result *nrqlConditionMutationResult
+
+	switch conditionType {
+	case "baseline":
+		err = client.Alerts.NerdGraphQueryWithContext(ctx, createNrqlConditionBaselineMutation, vars, &resp)
+		result = resp.AlertsNrqlConditionBaselineCreate
+	case "static":
+		err = client.Alerts.NerdGraphQueryWithContext(ctx, createNrqlConditionStaticMutation, vars, &resp)
+		result = resp.AlertsNrqlConditionStaticCreate
+	default:
+		return 0, fmt.Errorf("unsupported nrql alert condition type `%s`", conditionType)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if result == nil {
+		return 0, fmt.Errorf("error creating nrql alert condition: response was nil")
+	}
+
+	return strconv.Atoi(result.ID)
+}
+
+// Updates a NRQL alert condition of the given type, including any extended fields.
+func updateNrqlCondition(
+	ctx context.Context,
+	client *nr.NewRelic,
+	accountID int,
+	conditionID string,
+	conditionType string,
+	input interface{},
+	fields *nrqlConditionExtendedFields,
+) error {
+	condition, err := mergeNrqlConditionExtendedFields(input, fields)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"id":        conditionID,
+		"condition": condition,
+	}
+
+	resp := nrqlConditionMutationResponse{}
+
+	switch conditionType {
+	case "baseline":
+		return client.Alerts.NerdGraphQueryWithContext(ctx, updateNrqlConditionBaselineMutation, vars, &resp)
+	case "static":
+		return client.Alerts.NerdGraphQueryWithContext(ctx, updateNrqlConditionStaticMutation, vars, &resp)
+	}
+
+	return fmt.Errorf("unsupported nrql alert condition type `%s`", conditionType)
+}
+
+// Fetches the NRQL alert condition fields which are not returned by the alerts client.
+func getNrqlConditionExtendedFields(ctx context.Context, client *nr.NewRelic, accountID int, conditionID string) (*nrqlConditionExtendedFields, error) {
+	vars := map[string]interface{}{
+		"accountId": accountID,
+		"id":        conditionID,
+	}
+
+	resp := nrqlConditionExtendedFieldsResponse{}
+
+	if err := client.Alerts.NerdGraphQueryWithContext(ctx, getNrqlConditionExtendedFieldsQuery, vars, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Actor.Account.Alerts.NrqlCondition, nil
+}
@@ -120,19 +120,28 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"newrelic_account":                      dataSourceNewRelicAccount(),
-			"newrelic_alert_channel":                dataSourceNewRelicAlertChannel(),
-			"newrelic_alert_policy":                 dataSourceNewRelicAlertPolicy(),
-			"newrelic_application":                  dataSourceNewRelicApplication(),
-			"newrelic_cloud_account":                dataSourceNewRelicCloudAccount(),
-			"newrelic_entity":                       dataSourceNewRelicEntity(),
-			"newrelic_key_transaction":              dataSourceNewRelicKeyTransaction(),
-			"newrelic_notification_destination":     dataSourceNewRelicNotificationDestination(),
-			"newrelic_obfuscation_expression":       dataSourceNewRelicObfuscationExpression(),
-			"newrelic_synthetics_private_location":  dataSourceNewRelicSyntheticsPrivateLocation(),
-			"newrelic_synthetics_secure_credential": dataSourceNewRelicSyntheticsSecureCredential(),
-			"newrelic_test_grok_pattern":            dataSourceNewRelicTestGrokPattern(),
-			"newrelic_service_level_alert_helper":   dataSourceNewRelicServiceLevelAlertHelper(),
+			"newrelic_account":                         dataSourceNewRelicAccount(),
+			"newrelic_alert_channel":                   dataSourceNewRelicAlertChannel(),
+			"newrelic_alert_muting_rule_test":          dataSourceNewRelicAlertMutingRuleTest(),
+			"newrelic_alert_policy":                    dataSourceNewRelicAlertPolicy(),
+			"newrelic_application":                     dataSourceNewRelicApplication(),
+			"newrelic_cloud_account":                   dataSourceNewRelicCloudAccount(),
+			"newrelic_dashboard_convert":               dataSourceNewRelicDashboardConvert(),
+			"newrelic_dashboard_export":                dataSourceNewRelicDashboardExport(),
+			"newrelic_dashboard_snapshot":              dataSourceNewRelicDashboardSnapshot(),
+			"newrelic_entity":                          dataSourceNewRelicEntity(),
+			"newrelic_grafana_dashboard_conversion":    dataSourceNewRelicGrafanaDashboardConversion(),
+			"newrelic_key_transaction":                 dataSourceNewRelicKeyTransaction(),
+			"newrelic_notification_channel":            dataSourceNewRelicNotificationChannel(),
+			"newrelic_notification_destination":        dataSourceNewRelicNotificationDestination(),
+			"newrelic_nrql_alert_condition_conversion": dataSourceNewRelicNrqlAlertConditionConversion(),
+			"newrelic_obfuscation_expression":          dataSourceNewRelicObfuscationExpression(),
+			"newrelic_synthetics_private_location":     dataSourceNewRelicSyntheticsPrivateLocation(),
+			"newrelic_synthetics_secure_credential":    dataSourceNewRelicSyntheticsSecureCredential(),
+			"newrelic_test_grok_pattern":               dataSourceNewRelicTestGrokPattern(),
+			"newrelic_service_level_alert_helper":      dataSourceNewRelicServiceLevelAlertHelper(),
+			"newrelic_workflow":                        dataSourceNewRelicWorkflow(),
+			"newrelic_workflow_filter_test":            dataSourceNewRelicWorkflowFilterTest(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -141,6 +150,7 @@ func Provider() *schema.Provider {
 			"newrelic_alert_muting_rule":                        resourceNewRelicAlertMutingRule(),
 			"newrelic_alert_policy":                             resourceNewRelicAlertPolicy(),
 			"newrelic_alert_policy_channel":                     resourceNewRelicAlertPolicyChannel(),
+			"newrelic_alert_routing":                            resourceNewRelicAlertRouting(),
 			"newrelic_api_access_key":                           resourceNewRelicAPIAccessKey(),
 			"newrelic_application_settings":                     resourceNewRelicApplicationSettings(),
 			"newrelic_browser_application":                      resourceNewRelicBrowserApplication(),
@@ -152,6 +162,7 @@ func Provider() *schema.Provider {
 			"newrelic_cloud_azure_integrations":                 resourceNewRelicCloudAzureIntegrations(),
 			"newrelic_cloud_gcp_integrations":                   resourceNewrelicCloudGcpIntegrations(),
 			"newrelic_cloud_gcp_link_account":                   resourceNewRelicCloudGcpLinkAccount(),
+			"newrelic_dashboard_live_url":                       resourceNewRelicDashboardLiveURL(),
 			"newrelic_data_partition_rule":                      resourceNewRelicDataPartition(),
 			"newrelic_entity_tags":                              resourceNewRelicEntityTags(),
 			"newrelic_events_to_metrics_rule":                   resourceNewRelicEventsToMetricsRule(),
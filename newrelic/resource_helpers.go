@@ -2,12 +2,14 @@ package newrelic
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
 )
 
 // Generates a compound ID out of a slice of strings.
@@ -105,6 +107,128 @@ func resourceImportStateWithMetadata(defaultIDCount int, attribute string) schem
 	}
 }
 
+// entityGUID holds the decoded parts of an entity GUID, which is the base64 encoding
+// of "[accountID]|[domain]|[type]|[identifier]".
+type entityGUID struct {
+	GUID       string
+	AccountID  int
+	Domain     string
+	Type       string
+	Identifier string
+}
+
+// Decodes an entity GUID such as the one displayed in the New Relic One UI.
+func parseEntityGUID(guid string) (*entityGUID, error) {
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return nil, fmt.Errorf("`%s` is not a valid entity GUID", guid)
+	}
+
+	parts := strings.SplitN(string(decoded), "|", 4)
+	if len(parts) != 4 || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("`%s` is not a valid entity GUID", guid)
+	}
+
+	accountID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("`%s` is not a valid entity GUID", guid)
+	}
+
+	return &entityGUID{
+		GUID:       guid,
+		AccountID:  accountID,
+		Domain:     parts[1],
+		Type:       parts[2],
+		Identifier: parts[3],
+	}, nil
+}
+
+// Handles importing of entity-backed resources by their entity GUID, in addition to
+// the resource's own import ID.
+//
+// When the import ID decodes to an entity GUID, the `resolve` function is called to
+// set the resource ID (and any attributes needed to read the resource) from it.
+// The `domain` and `entityType` arguments restrict the accepted GUIDs, unless empty.
+// Any other import ID is handled by the `fallback` importer, or passed through if nil.
+func resourceImportStateWithEntityGUID(
+	domain string,
+	entityType string,
+	resolve func(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error,
+	fallback schema.StateContextFunc,
+) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		guid, err := parseEntityGUID(d.Id())
+		if err != nil || strings.Contains(d.Id(), ":") {
+			if fallback == nil {
+				return []*schema.ResourceData{d}, nil
+			}
+
+			return fallback(ctx, d, meta)
+		}
+
+		if (domain != "" && guid.Domain != domain) || (entityType != "" && guid.Type != entityType) {
+			return []*schema.ResourceData{}, fmt.Errorf("entity GUID `%s` identifies a %s|%s entity, expected %s|%s", guid.GUID, guid.Domain, guid.Type, domain, entityType)
+		}
+
+		log.Printf("[INFO] Importing %s|%s entity %s of account %d", guid.Domain, guid.Type, guid.Identifier, guid.AccountID)
+
+		if err := resolve(ctx, d, meta, guid); err != nil {
+			return []*schema.ResourceData{}, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// Looks up the policy holding an alert condition which can only be read through its policy,
+// for importing it by its entity GUID. The `hasCondition` function reports whether a policy
+// holds the condition.
+func findAlertConditionPolicyID(ctx context.Context, meta interface{}, guid *entityGUID, hasCondition func(policyID int) (bool, error)) (int, error) {
+	client := meta.(*ProviderConfig).NewClient
+
+	policies, err := client.Alerts.QueryPolicySearchWithContext(ctx, guid.AccountID, alerts.AlertsPoliciesSearchCriteriaInput{})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, policy := range policies {
+		policyID, err := strconv.Atoi(policy.ID)
+		if err != nil {
+			return 0, err
+		}
+
+		found, err := hasCondition(policyID)
+		if err != nil {
+			return 0, err
+		}
+
+		if found {
+			return policyID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no policy of account %d holds the alert condition with entity GUID `%s`", guid.AccountID, guid.GUID)
+}
+
+// Builds the resource data of a resource out of a map of attribute values, such as a
+// configuration block or the attributes of a state file, so that the resource's own
+// expand functions can be used. Attributes which are not part of the schema are ignored.
+func resourceDataFromAttributes(r *schema.Resource, attributes map[string]interface{}) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+
+	for k, v := range attributes {
+		if _, ok := r.Schema[k]; !ok || v == nil {
+			continue
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
 // Selects the proper accountID for usage within a resource. An account ID provided
 // within a `resource` block will override a `provider` block account ID. This ensures
 // resources can be scoped to specific accounts. Bear in mind those accounts must be
@@ -84,7 +84,7 @@ func resourceNewRelicAlertCondition() *schema.Resource {
 		UpdateContext:      resourceNewRelicAlertConditionUpdate,
 		DeleteContext:      resourceNewRelicAlertConditionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithEntityGUID("AIOPS", "CONDITION", resourceNewRelicAlertConditionImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
@@ -227,6 +227,37 @@ func resourceNewRelicAlertConditionCreate(ctx context.Context, d *schema.Resourc
 	return resourceNewRelicAlertConditionRead(ctx, d, meta)
 }
 
+func resourceNewRelicAlertConditionImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+
+	conditionID, err := strconv.Atoi(guid.Identifier)
+	if err != nil {
+		return err
+	}
+
+	policyID, err := findAlertConditionPolicyID(ctx, meta, guid, func(policyID int) (bool, error) {
+		conditions, err := client.Alerts.ListConditionsWithContext(ctx, policyID)
+		if err != nil {
+			return false, err
+		}
+
+		for _, condition := range conditions {
+			if condition.ID == conditionID {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serializeIDs([]int{policyID, conditionID}))
+
+	return nil
+}
+
 func resourceNewRelicAlertConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := meta.(*ProviderConfig).NewClient
@@ -99,6 +99,47 @@ func scheduleSchema() *schema.Resource {
 	}
 }
 
+func mutingRuleConditionGroupSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The individual MutingRuleConditions within the group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMutingRuleConditionAttribute,
+							Description:  "The attribute on an incident.",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The operator used to compare the attribute's value with the supplied value(s).",
+							ValidateFunc: validation.StringInSlice([]string{"ANY", "CONTAINS", "ENDS_WITH", "EQUALS", "IN", "IS_BLANK", "IS_NOT_BLANK", "NOT_CONTAINS", "NOT_ENDS_WITH", "NOT_EQUALS", "NOT_IN", "NOT_STARTS_WITH", "STARTS_WITH"}, true),
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The value(s) to compare against the attribute's value.",
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The operator used to combine all the MutingRuleConditions within the group.",
+				ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, true),
+			},
+		},
+	}
+}
+
 func resourceNewRelicAlertMutingRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNewRelicAlertMutingRuleCreate,
@@ -108,6 +149,7 @@ func resourceNewRelicAlertMutingRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffMutingRuleSchedule,
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -121,44 +163,7 @@ func resourceNewRelicAlertMutingRule() *schema.Resource {
 				Description: "The condition that defines which incidents to target.",
 				MaxItems:    1,
 				MinItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"conditions": {
-							Type:        schema.TypeList,
-							Required:    true,
-							Description: "The individual MutingRuleConditions within the group.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"attribute": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validateMutingRuleConditionAttribute,
-										Description:  "The attribute on an incident.",
-									},
-									"operator": {
-										Type:         schema.TypeString,
-										Required:     true,
-										Description:  "The operator used to compare the attribute's value with the supplied value(s).",
-										ValidateFunc: validation.StringInSlice([]string{"ANY", "CONTAINS", "ENDS_WITH", "EQUALS", "IN", "IS_BLANK", "IS_NOT_BLANK", "NOT_CONTAINS", "NOT_ENDS_WITH", "NOT_EQUALS", "NOT_IN", "NOT_STARTS_WITH", "STARTS_WITH"}, true),
-									},
-									"values": {
-										Type:        schema.TypeList,
-										Required:    true,
-										Description: "The value(s) to compare against the attribute's value.",
-										MinItems:    1,
-										Elem:        &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"operator": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "The operator used to combine all the MutingRuleConditions within the group.",
-							ValidateFunc: validation.StringInSlice([]string{"AND", "OR"}, true),
-						},
-					},
-				},
+				Elem:        mutingRuleConditionGroupSchema(),
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
@@ -183,6 +188,27 @@ func resourceNewRelicAlertMutingRule() *schema.Resource {
 				Elem:        scheduleSchema(),
 				Description: "The time window when the MutingRule should actively mute incidents.",
 			},
+			"ical_event": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"schedule", "rrule"},
+				ValidateFunc:  validateMutingRuleICalEvent,
+				Description:   "An RFC 5545 iCalendar event whose DTSTART, DTEND and RRULE define the MutingRule schedule.",
+			},
+			"rrule": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ical_event", "schedule.0.repeat", "schedule.0.end_repeat", "schedule.0.repeat_count", "schedule.0.weekly_repeat_days"},
+				RequiredWith:  []string{"schedule"},
+				ValidateFunc:  validateMutingRuleRRule,
+				Description:   "An RFC 5545 recurrence rule defining how the MutingRule schedule repeats.",
+			},
+			"next_occurrences": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The start times of the next windows of the MutingRule schedule, computed locally.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
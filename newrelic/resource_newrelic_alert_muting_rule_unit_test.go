@@ -0,0 +1,300 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMutingRuleSchedule(t *testing.T) {
+	cases := map[string]struct {
+		Schedule map[string]interface{}
+		Error    string
+	}{
+		"valid weekly schedule": {
+			Schedule: map[string]interface{}{"time_zone": "America/Los_Angeles", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T10:00:00", "repeat": "WEEKLY", "weekly_repeat_days": []interface{}{"MONDAY"}, "repeat_count": 3},
+		},
+		"valid one time schedule": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00"},
+		},
+		"unknown time zone": {
+			Schedule: map[string]interface{}{"time_zone": "Mars/Olympus_Mons", "start_time": "2026-01-05T09:00:00"},
+			Error:    "is not a known IANA time zone",
+		},
+		"local time zone": {
+			Schedule: map[string]interface{}{"time_zone": "Local", "start_time": "2026-01-05T09:00:00"},
+			Error:    "must be an IANA time zone",
+		},
+		"end time before start time": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T08:00:00"},
+			Error:    "`end_time` must be after `start_time`",
+		},
+		"weekly repeat days without weekly repeat": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T10:00:00", "repeat": "DAILY", "weekly_repeat_days": []interface{}{"MONDAY"}},
+			Error:    "requires `repeat` to be `WEEKLY`",
+		},
+		"end repeat and repeat count": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T10:00:00", "repeat": "DAILY", "end_repeat": "2026-02-05T09:00:00", "repeat_count": 3},
+			Error:    "cannot both be set",
+		},
+		"repeat count without repeat": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T10:00:00", "repeat_count": 3},
+			Error:    "require `repeat` to be set",
+		},
+		"repeat without end time": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00", "repeat": "DAILY"},
+			Error:    "requires both `start_time` and `end_time`",
+		},
+		"end repeat before start time": {
+			Schedule: map[string]interface{}{"time_zone": "UTC", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T10:00:00", "repeat": "DAILY", "end_repeat": "2026-01-01T09:00:00"},
+			Error:    "`end_repeat` must be after `start_time`",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := resourceNewRelicAlertMutingRule()
+			d := r.TestResourceData()
+			require.NoError(t, d.Set("schedule", []interface{}{tc.Schedule}))
+
+			schedule, err := expandMutingRuleCreateSchedule(d.Get("schedule.0").(map[string]interface{}))
+			require.NoError(t, err)
+
+			err = validateMutingRuleSchedule(schedule)
+			if tc.Error == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.Error)
+			}
+		})
+	}
+}
+
+func TestMutingRuleScheduleNextOccurrences(t *testing.T) {
+	start, err := time.Parse("2006-01-02T15:04:05", "2026-01-05T09:00:00")
+	require.NoError(t, err)
+	end := start.Add(time.Hour)
+	repeat := alerts.MutingRuleScheduleRepeatTypes.WEEKLY
+	days := []alerts.DayOfWeek{"MONDAY", "FRIDAY"}
+	count := 4
+
+	schedule := alerts.MutingRuleScheduleCreateInput{
+		TimeZone:         "Europe/Paris",
+		StartTime:        &alerts.NaiveDateTime{Time: start},
+		EndTime:          &alerts.NaiveDateTime{Time: end},
+		Repeat:           &repeat,
+		WeeklyRepeatDays: &days,
+		RepeatCount:      &count,
+	}
+
+	now, err := time.Parse(time.RFC3339, "2026-01-09T08:30:00Z")
+	require.NoError(t, err)
+
+	occurrences, err := mutingRuleScheduleNextOccurrences(schedule, now, 5)
+	require.NoError(t, err)
+
+	// The Friday window started at 08:00 UTC and is still active.
+	assert.Equal(t, []string{"2026-01-09T09:00:00+01:00", "2026-01-12T09:00:00+01:00", "2026-01-16T09:00:00+01:00"}, occurrences)
+
+	occurrences, err = mutingRuleScheduleNextOccurrences(schedule, now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2026-01-09T09:00:00+01:00"}, occurrences)
+}
+
+func TestAlertMutingRuleScheduleCustomizeDiff(t *testing.T) {
+	condition := []interface{}{
+		map[string]interface{}{
+			"operator": "AND",
+			"conditions": []interface{}{
+				map[string]interface{}{"attribute": "policyName", "operator": "EQUALS", "values": []interface{}{"Checkout"}},
+			},
+		},
+	}
+
+	r := resourceNewRelicAlertMutingRule()
+
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":      "muting rule",
+		"enabled":   true,
+		"condition": condition,
+		"schedule": []interface{}{
+			map[string]interface{}{"time_zone": "America/Nowhere", "start_time": "2026-01-05T09:00:00"},
+		},
+	}), nil)
+	require.ErrorContains(t, err, "is not a known IANA time zone")
+
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":      "muting rule",
+		"enabled":   true,
+		"condition": condition,
+		"schedule": []interface{}{
+			map[string]interface{}{"time_zone": "UTC", "start_time": "2126-01-05T09:00:00", "end_time": "2126-01-05T10:00:00", "repeat": "DAILY", "repeat_count": 2},
+		},
+	}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2", diff.Attributes["next_occurrences.#"].New)
+	assert.Equal(t, "2126-01-05T09:00:00Z", diff.Attributes["next_occurrences.0"].New)
+	assert.Equal(t, "2126-01-06T09:00:00Z", diff.Attributes["next_occurrences.1"].New)
+}
+
+func TestParseMutingRuleICalEvent(t *testing.T) {
+	cases := map[string]struct {
+		Event    string
+		Expected map[string]interface{}
+		Error    string
+	}{
+		"weekly event with count": {
+			Event: "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nUID:maintenance@example.com\r\nDTSTART;TZID=Europe/Paris:20260105T220000\r\nDTEND;TZID=Europe/Paris:20260106T020000\r\nRRULE:FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10\r\nBEGIN:VALARM\r\nTRIGGER:-PT15M\r\nACTION:DISPLAY\r\nEND:VALARM\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			Expected: map[string]interface{}{
+				"time_zone":          "Europe/Paris",
+				"start_time":         "2026-01-05T22:00:00",
+				"end_time":           "2026-01-06T02:00:00",
+				"repeat":             "WEEKLY",
+				"repeat_count":       10,
+				"end_repeat":         "",
+				"weekly_repeat_days": []string{"MONDAY", "THURSDAY"},
+			},
+		},
+		"utc event with until and folded lines": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000Z\nDTEND:20260105T23\n 0000Z\nRRULE:FREQ=MONTHLY;UNTIL=20260\n 601T000000Z\nEND:VEVENT\n",
+			Expected: map[string]interface{}{
+				"time_zone":  "UTC",
+				"start_time": "2026-01-05T22:00:00",
+				"end_time":   "2026-01-05T23:00:00",
+				"repeat":     "MONTHLY",
+				"end_repeat": "2026-06-01T00:00:00",
+			},
+		},
+		"all day event in the calendar time zone": {
+			Event: "BEGIN:VCALENDAR\nX-WR-TIMEZONE:America/New_York\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20260704\nEND:VEVENT\nEND:VCALENDAR\n",
+			Expected: map[string]interface{}{
+				"time_zone":  "America/New_York",
+				"start_time": "2026-07-04T00:00:00",
+				"end_time":   "2026-07-05T00:00:00",
+			},
+		},
+		"daily on week days": {
+			Event: "BEGIN:VEVENT\nDTSTART;TZID=UTC:20260105T010000\nDTEND;TZID=UTC:20260105T020000\nRRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;UNTIL=20260131\nEND:VEVENT\n",
+			Expected: map[string]interface{}{
+				"time_zone":          "UTC",
+				"start_time":         "2026-01-05T01:00:00",
+				"end_time":           "2026-01-05T02:00:00",
+				"repeat":             "WEEKLY",
+				"end_repeat":         "2026-01-31T23:59:59",
+				"weekly_repeat_days": []string{"FRIDAY", "MONDAY", "THURSDAY", "TUESDAY", "WEDNESDAY"},
+			},
+		},
+		"floating time": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000\nEND:VEVENT\n",
+			Error: "must have a TZID parameter or be in UTC",
+		},
+		"several events": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000Z\nEND:VEVENT\nBEGIN:VEVENT\nDTSTART:20260106T220000Z\nEND:VEVENT\n",
+			Error: "has 2 VEVENT",
+		},
+		"interval": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000Z\nDTEND:20260105T230000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2\nEND:VEVENT\n",
+			Error: "INTERVAL=2 cannot be represented",
+		},
+		"yearly": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000Z\nDTEND:20260105T230000Z\nRRULE:FREQ=YEARLY\nEND:VEVENT\n",
+			Error: "FREQ=YEARLY cannot be represented",
+		},
+		"ordinal week day": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000Z\nDTEND:20260105T230000Z\nRRULE:FREQ=MONTHLY;BYDAY=1MO\nEND:VEVENT\n",
+			Error: "BYDAY=1MO cannot be represented",
+		},
+		"exception dates": {
+			Event: "BEGIN:VEVENT\nDTSTART:20260105T220000Z\nDTEND:20260105T230000Z\nRRULE:FREQ=DAILY\nEXDATE:20260106T220000Z\nEND:VEVENT\n",
+			Error: "EXDATE cannot be represented",
+		},
+		"windows time zone": {
+			Event: "BEGIN:VEVENT\nDTSTART;TZID=\"Pacific Standard Time\":20260105T220000\nEND:VEVENT\n",
+			Error: "is not a known IANA time zone",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := parseMutingRuleICalEvent(tc.Event)
+			if tc.Error != "" {
+				require.ErrorContains(t, err, tc.Error)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, mutingRuleScheduleConfigsEqual(tc.Expected, cfg, mutingRuleScheduleAttributes), "%v", cfg)
+		})
+	}
+}
+
+func TestExpandMutingRuleCreateInput_RRule(t *testing.T) {
+	r := resourceNewRelicAlertMutingRule()
+	d := r.TestResourceData()
+	require.NoError(t, d.Set("schedule", []interface{}{
+		map[string]interface{}{"time_zone": "America/Los_Angeles", "start_time": "2026-01-05T09:00:00", "end_time": "2026-01-05T10:00:00"},
+	}))
+	require.NoError(t, d.Set("rrule", "RRULE:FREQ=WEEKLY;BYDAY=MO;UNTIL=20260301T000000Z"))
+	require.NoError(t, d.Set("condition", []interface{}{
+		map[string]interface{}{"operator": "AND", "conditions": []interface{}{}},
+	}))
+
+	input, err := expandMutingRuleCreateInput(d)
+	require.NoError(t, err)
+	require.NotNil(t, input.Schedule)
+
+	assert.Equal(t, alerts.MutingRuleScheduleRepeatTypes.WEEKLY, *input.Schedule.Repeat)
+	assert.Equal(t, []alerts.DayOfWeek{"MONDAY"}, *input.Schedule.WeeklyRepeatDays)
+	assert.Equal(t, "2026-02-28T16:00:00", input.Schedule.EndRepeat.Format("2006-01-02T15:04:05"))
+	assert.Equal(t, "2026-01-05T09:00:00", input.Schedule.StartTime.Format("2006-01-02T15:04:05"))
+}
+
+func TestFlattenMutingRuleScheduleWithRecurrence(t *testing.T) {
+	start := time.Date(2026, 1, 5, 22, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+	repeat := alerts.MutingRuleScheduleRepeatTypes.WEEKLY
+	days := []alerts.DayOfWeek{"THURSDAY", "MONDAY"}
+	count := 10
+
+	schedule := &alerts.MutingRuleSchedule{
+		StartTime:        &start,
+		EndTime:          &end,
+		TimeZone:         "Europe/Paris",
+		Repeat:           &repeat,
+		RepeatCount:      &count,
+		WeeklyRepeatDays: &days,
+	}
+
+	r := resourceNewRelicAlertMutingRule()
+	d := r.TestResourceData()
+	require.NoError(t, d.Set("ical_event", "BEGIN:VEVENT\nDTSTART;TZID=Europe/Paris:20260105T220000\nDTEND;TZID=Europe/Paris:20260106T020000\nRRULE:FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10\nEND:VEVENT\n"))
+
+	flattened, err := flattenMutingRuleScheduleWithRecurrence(schedule, d)
+	require.NoError(t, err)
+	assert.Empty(t, flattened)
+
+	count = 12
+	flattened, err = flattenMutingRuleScheduleWithRecurrence(schedule, d)
+	require.NoError(t, err)
+	require.Len(t, flattened, 1)
+	assert.Equal(t, 12, flattened[0].(map[string]interface{})["repeat_count"])
+
+	d = r.TestResourceData()
+	require.NoError(t, d.Set("rrule", "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=12"))
+	require.NoError(t, d.Set("schedule", []interface{}{
+		map[string]interface{}{"time_zone": "Europe/Paris", "start_time": "2026-01-05T22:00:00", "end_time": "2026-01-06T02:00:00"},
+	}))
+
+	flattened, err = flattenMutingRuleScheduleWithRecurrence(schedule, d)
+	require.NoError(t, err)
+	require.Len(t, flattened, 1)
+	assert.Equal(t, map[string]interface{}{"time_zone": "Europe/Paris", "start_time": "2026-01-05T22:00:00", "end_time": "2026-01-06T02:00:00"}, flattened[0])
+}
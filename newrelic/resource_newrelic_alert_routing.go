@@ -0,0 +1,420 @@
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+)
+
+// The channel types created for each destination type of an alert routing.
+var alertRoutingChannelTypes = map[notifications.AiNotificationsDestinationType]notifications.AiNotificationsChannelType{
+	notifications.AiNotificationsDestinationTypeTypes.WEBHOOK:                       notifications.AiNotificationsChannelTypeTypes.WEBHOOK,
+	notifications.AiNotificationsDestinationTypeTypes.EMAIL:                         notifications.AiNotificationsChannelTypeTypes.EMAIL,
+	notifications.AiNotificationsDestinationTypeTypes.SERVICE_NOW:                   notifications.AiNotificationsChannelTypeTypes.SERVICENOW_INCIDENTS,
+	notifications.AiNotificationsDestinationTypeTypes.PAGERDUTY_ACCOUNT_INTEGRATION: notifications.AiNotificationsChannelTypeTypes.PAGERDUTY_ACCOUNT_INTEGRATION,
+	notifications.AiNotificationsDestinationTypeTypes.PAGERDUTY_SERVICE_INTEGRATION: notifications.AiNotificationsChannelTypeTypes.PAGERDUTY_SERVICE_INTEGRATION,
+	notifications.AiNotificationsDestinationTypeTypes.JIRA:                          notifications.AiNotificationsChannelTypeTypes.JIRA_CLASSIC,
+	notifications.AiNotificationsDestinationTypeTypes.SLACK_LEGACY:                  notifications.AiNotificationsChannelTypeTypes.SLACK_LEGACY,
+	notifications.AiNotificationsDestinationTypeTypes.MOBILE_PUSH:                   notifications.AiNotificationsChannelTypeTypes.MOBILE_PUSH,
+	notifications.AiNotificationsDestinationTypeTypes.EVENT_BRIDGE:                  notifications.AiNotificationsChannelTypeTypes.EVENT_BRIDGE,
+}
+
+func resourceNewRelicAlertRouting() *schema.Resource {
+	destination := resourceNewRelicNotificationDestination().Schema
+
+	authBasic := *destination["auth_basic"]
+	authBasic.ConflictsWith = []string{"destination.0.auth_token"}
+
+	authToken := *destination["auth_token"]
+	authToken.ConflictsWith = []string{"destination.0.auth_basic"}
+
+	return &schema.Resource{
+		CreateContext: resourceNewRelicAlertRoutingCreate,
+		ReadContext:   resourceNewRelicAlertRoutingRead,
+		UpdateContext: resourceNewRelicAlertRoutingUpdate,
+		DeleteContext: resourceNewRelicAlertRoutingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The account id of the destination, channel and workflow.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the destination, channel and workflow.",
+			},
+			"policy_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The IDs of the alert policies whose issues are routed.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"destination": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The notification destination the issues are routed to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(listValidAlertRoutingDestinationTypes(), false),
+							Description:  fmt.Sprintf("The type of the destination. One of: (%s).", strings.Join(listValidAlertRoutingDestinationTypes(), ", ")),
+						},
+						"property": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Notification destination property type.",
+							Elem:        notificationsPropertySchema(),
+						},
+						"auth_basic": &authBasic,
+						"auth_token": &authToken,
+					},
+				},
+			},
+			"channel": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The notification channel sending the issues to the destination.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(listValidNotificationsChannelTypes(), false),
+							Description:  "The type of the channel. Defaults to the channel type of the destination type.",
+						},
+						"product": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(notifications.AiNotificationsProductTypes.IINT),
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(listValidNotificationsProductTypes(), false),
+							Description:  fmt.Sprintf("The type of the channel product. One of: (%s).", strings.Join(listValidNotificationsProductTypes(), ", ")),
+						},
+						"property": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Notification channel property type.",
+							Elem:        notificationsPropertySchema(),
+						},
+					},
+				},
+			},
+			"muting_rules_handling": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(workflows.AiWorkflowsMutingRulesHandlingTypes.NOTIFY_ALL_ISSUES),
+				ValidateFunc: validation.StringInSlice(listValidMutingRulesTypes(), false),
+				Description:  fmt.Sprintf("The type of the muting rule handling of the workflow. One of: (%s).", strings.Join(listValidMutingRulesTypes(), ", ")),
+			},
+			"notification_triggers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "List of triggers to notify about.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Indicates whether the workflow is enabled.",
+			},
+			"enrichments": resourceNewRelicWorkflow().Schema["enrichments"],
+
+			// Computed
+			"destination_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the destination.",
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the channel.",
+			},
+		},
+	}
+}
+
+// Validation function to validate allowed alert routing destination types
+func listValidAlertRoutingDestinationTypes() []string {
+	types := []string{}
+	for t := range alertRoutingChannelTypes {
+		types = append(types, string(t))
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+func resourceNewRelicAlertRoutingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Creating New Relic alert routing %s", d.Get("name").(string))
+
+	children, err := newAlertRoutingChildren(d.Get, accountID, "", "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	created := []*alertRoutingChild{}
+	for _, child := range children.list() {
+		switch child {
+		case children.Channel:
+			if err := child.Data.Set("destination_id", children.Destination.Data.Id()); err != nil {
+				return append(diag.FromErr(err), rollbackAlertRoutingCreate(ctx, created, meta)...)
+			}
+		case children.Workflow:
+			if err := setAlertRoutingWorkflowChannel(child.Data, children.Channel.Data.Id()); err != nil {
+				return append(diag.FromErr(err), rollbackAlertRoutingCreate(ctx, created, meta)...)
+			}
+		}
+
+		diags := child.Resource.CreateContext(ctx, child.Data, meta)
+		if child.Data.Id() != "" {
+			created = append(created, child)
+		}
+
+		if diags.HasError() {
+			return append(diags, rollbackAlertRoutingCreate(ctx, created, meta)...)
+		}
+	}
+
+	d.SetId(children.Workflow.Data.Id())
+
+	if err := d.Set("account_id", accountID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("destination_id", children.Destination.Data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("channel_id", children.Channel.Data.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceNewRelicAlertRoutingRead(ctx, d, meta)
+}
+
+// Deletes the objects of an alert routing created before one of them failed to be created.
+func rollbackAlertRoutingCreate(ctx context.Context, created []*alertRoutingChild, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := len(created) - 1; i >= 0; i-- {
+		child := created[i]
+
+		log.Printf("[INFO] Rolling back New Relic %s %s of alert routing", child.Kind, child.Data.Id())
+
+		for _, e := range child.Resource.DeleteContext(ctx, child.Data, meta) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: e.Severity,
+				Summary:  fmt.Sprintf("could not roll back %s %s: %s", child.Kind, child.Data.Id(), e.Summary),
+				Detail:   e.Detail,
+			})
+		}
+	}
+
+	return diags
+}
+
+func resourceNewRelicAlertRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Reading New Relic alert routing %s", d.Id())
+
+	children, err := newAlertRoutingChildren(d.Get, accountID, "", "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	children.Workflow.Data.SetId(d.Id())
+	if diags := children.Workflow.Resource.ReadContext(ctx, children.Workflow.Data, meta); diags.HasError() {
+		return diags
+	}
+
+	channelID := d.Get("channel_id").(string)
+	if channelID == "" {
+		// Imported alert routings are identified by their workflow only.
+		channelID = alertRoutingWorkflowChannelID(children.Workflow.Data)
+	}
+	children.Channel.Data.SetId(channelID)
+
+	if children.Workflow.Data.Id() != "" && channelID != "" {
+		if diags := children.Channel.Resource.ReadContext(ctx, children.Channel.Data, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	destinationID := d.Get("destination_id").(string)
+	if destinationID == "" {
+		destinationID = children.Channel.Data.Get("destination_id").(string)
+	}
+	children.Destination.Data.SetId(destinationID)
+
+	if children.Channel.Data.Id() != "" && destinationID != "" {
+		if diags := children.Destination.Resource.ReadContext(ctx, children.Destination.Data, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, child := range children.list() {
+		if child.Data.Id() == "" {
+			log.Printf("[WARN] New Relic %s of alert routing %s not found, removing from state", child.Kind, d.Id())
+			d.SetId("")
+			return nil
+		}
+	}
+
+	return diag.FromErr(flattenAlertRouting(children, d))
+}
+
+func resourceNewRelicAlertRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Updating New Relic alert routing %s", d.Id())
+
+	previous, err := newAlertRoutingChildren(func(key string) interface{} {
+		o, _ := d.GetChange(key)
+		return o
+	}, accountID, d.Get("destination_id").(string), d.Get("channel_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	children, err := newAlertRoutingChildren(d.Get, accountID, d.Get("destination_id").(string), d.Get("channel_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	changes := map[*alertRoutingChild]bool{
+		children.Destination: d.HasChanges("name", "destination"),
+		children.Channel:     d.HasChanges("name", "channel"),
+		children.Workflow:    d.HasChanges("name", "policy_ids", "muting_rules_handling", "notification_triggers", "enabled", "enrichments"),
+	}
+
+	previousChildren := map[*alertRoutingChild]*alertRoutingChild{
+		children.Destination: previous.Destination,
+		children.Channel:     previous.Channel,
+		children.Workflow:    previous.Workflow,
+	}
+
+	updated := []*alertRoutingChild{}
+	for _, child := range children.list() {
+		if !changes[child] {
+			continue
+		}
+
+		if child == children.Workflow {
+			child.Data.SetId(d.Id())
+			previous.Workflow.Data.SetId(d.Id())
+		}
+
+		if diags := prepareAlertRoutingChildUpdate(ctx, child, meta); diags.HasError() {
+			return append(diags, rollbackAlertRoutingUpdate(ctx, updated, previousChildren, meta)...)
+		}
+
+		diags := child.Resource.UpdateContext(ctx, child.Data, meta)
+		if diags.HasError() {
+			return append(diags, rollbackAlertRoutingUpdate(ctx, updated, previousChildren, meta)...)
+		}
+
+		updated = append(updated, child)
+	}
+
+	return resourceNewRelicAlertRoutingRead(ctx, d, meta)
+}
+
+// Reads the remote attributes of an object of an alert routing which are required to update
+// it, e.g. the ID of the issues filter of a workflow, keeping the configured attributes.
+func prepareAlertRoutingChildUpdate(ctx context.Context, child *alertRoutingChild, meta interface{}) diag.Diagnostics {
+	if child.Resource.Schema["issues_filter"] == nil {
+		return nil
+	}
+
+	current := child.Resource.Data(nil)
+	current.SetId(child.Data.Id())
+	if err := current.Set("account_id", child.Data.Get("account_id")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := child.Resource.ReadContext(ctx, current, meta); diags.HasError() {
+		return diags
+	}
+
+	return diag.FromErr(setAlertRoutingWorkflowFilterID(child.Data, current))
+}
+
+// Restores the objects of an alert routing updated before one of them failed to be updated.
+func rollbackAlertRoutingUpdate(ctx context.Context, updated []*alertRoutingChild, previous map[*alertRoutingChild]*alertRoutingChild, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := len(updated) - 1; i >= 0; i-- {
+		child := previous[updated[i]]
+
+		log.Printf("[INFO] Rolling back New Relic %s %s of alert routing", child.Kind, child.Data.Id())
+
+		rollbackDiags := prepareAlertRoutingChildUpdate(ctx, child, meta)
+		if !rollbackDiags.HasError() {
+			rollbackDiags = child.Resource.UpdateContext(ctx, child.Data, meta)
+		}
+
+		for _, e := range rollbackDiags {
+			diags = append(diags, diag.Diagnostic{
+				Severity: e.Severity,
+				Summary:  fmt.Sprintf("could not roll back %s %s: %s", child.Kind, child.Data.Id(), e.Summary),
+				Detail:   e.Detail,
+			})
+		}
+	}
+
+	return diags
+}
+
+func resourceNewRelicAlertRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConfig := meta.(*ProviderConfig)
+	accountID := selectAccountID(providerConfig, d)
+
+	log.Printf("[INFO] Deleting New Relic alert routing %s", d.Id())
+
+	children, err := newAlertRoutingChildren(d.Get, accountID, d.Get("destination_id").(string), d.Get("channel_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	children.Workflow.Data.SetId(d.Id())
+
+	// The workflow is deleted first, as channels and destinations in use can't be deleted.
+	list := children.list()
+	for i := len(list) - 1; i >= 0; i-- {
+		if diags := list[i].Resource.DeleteContext(ctx, list[i].Data, meta); diags.HasError() {
+			return diags
+		}
+	}
+
+	return nil
+}
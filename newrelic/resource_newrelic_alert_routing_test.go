@@ -0,0 +1,135 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+)
+
+func TestNewRelicAlertRouting_Webhook(t *testing.T) {
+	resourceName := "newrelic_alert_routing.foo"
+	rName := generateNameForIntegrationTestResource()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccNewRelicAlertRoutingDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create alert routing
+			{
+				Config: testAccNewRelicAlertRoutingConfigurationWebhook(testAccountID, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicWorkflowExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "channel.0.type", "WEBHOOK"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicAlertRoutingConfigurationWebhook(testAccountID, fmt.Sprintf("%s-updated", rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-updated", rName)),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"destination.0.auth_basic.0.password"},
+			},
+		},
+	})
+}
+
+func testAccNewRelicAlertRoutingConfigurationWebhook(accountID int, name string) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+  account_id = %[1]d
+  name       = "%[2]s"
+}
+
+resource "newrelic_alert_routing" "foo" {
+  account_id = %[1]d
+  name       = "%[2]s"
+  policy_ids = [newrelic_alert_policy.foo.id]
+
+  destination {
+    type = "WEBHOOK"
+
+    property {
+      key   = "url"
+      value = "https://webhook.site/"
+    }
+
+    auth_basic {
+      user     = "username"
+      password = "password"
+    }
+  }
+
+  channel {
+    property {
+      key   = "payload"
+      value = "{\n\t\"name\": \"foo\"\n}"
+      label = "Payload Template"
+    }
+  }
+
+  enrichments {
+    nrql {
+      name = "Log"
+      configuration {
+        query = "SELECT count(*) FROM Log"
+      }
+    }
+  }
+}
+`, accountID, name)
+}
+
+func testAccNewRelicAlertRoutingDestroy(s *terraform.State) error {
+	providerConfig := testAccProvider.Meta().(*ProviderConfig)
+	client := providerConfig.NewClient
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_alert_routing" {
+			continue
+		}
+
+		workflowResponse, err := client.Workflows.GetWorkflows(testAccountID, "", ai.AiWorkflowsFilters{ID: r.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if len(workflowResponse.Entities) > 0 {
+			return fmt.Errorf("workflow of alert routing still exists")
+		}
+
+		channelResponse, err := client.Notifications.GetChannels(testAccountID, "", ai.AiNotificationsChannelFilter{ID: r.Primary.Attributes["channel_id"]}, notifications.AiNotificationsChannelSorter{})
+		if err != nil {
+			return err
+		}
+		if len(channelResponse.Entities) > 0 {
+			return fmt.Errorf("channel of alert routing still exists")
+		}
+
+		destinationResponse, err := client.Notifications.GetDestinations(testAccountID, "", ai.AiNotificationsDestinationFilter{ID: r.Primary.Attributes["destination_id"]}, notifications.AiNotificationsDestinationSorter{})
+		if err != nil {
+			return err
+		}
+		if len(destinationResponse.Entities) > 0 {
+			return fmt.Errorf("destination of alert routing still exists")
+		}
+	}
+
+	return nil
+}
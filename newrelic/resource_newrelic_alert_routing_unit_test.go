@@ -0,0 +1,165 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAlertRoutingResourceData(t *testing.T) *schema.ResourceData {
+	r := resourceNewRelicAlertRouting()
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	return schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":       "checkout",
+		"policy_ids": []interface{}{"123", "456"},
+		"destination": []interface{}{
+			map[string]interface{}{
+				"type": "WEBHOOK",
+				"property": []interface{}{
+					map[string]interface{}{"key": "url", "value": "https://example.com/hook"},
+				},
+				"auth_token": []interface{}{
+					map[string]interface{}{"prefix": "Bearer", "token": "secret"},
+				},
+			},
+		},
+		"channel": []interface{}{
+			map[string]interface{}{
+				"property": []interface{}{
+					map[string]interface{}{"key": "payload", "value": `{"id": {{ json issueId }}}`},
+				},
+			},
+		},
+		"notification_triggers": []interface{}{"ACTIVATED"},
+		"enrichments": []interface{}{
+			map[string]interface{}{
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"name":          "errors",
+						"configuration": []interface{}{map[string]interface{}{"query": "SELECT count(*) FROM TransactionError"}},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestNewAlertRoutingChildren(t *testing.T) {
+	d := testAlertRoutingResourceData(t)
+
+	children, err := newAlertRoutingChildren(d.Get, 1234, "destination-id", "channel-id")
+	require.NoError(t, err)
+
+	destination, err := expandNotificationDestination(children.Destination.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "checkout", destination.Name)
+	assert.Equal(t, notifications.AiNotificationsDestinationTypeTypes.WEBHOOK, destination.Type)
+	assert.Equal(t, notifications.AiNotificationsAuthTypeTypes.TOKEN, destination.Auth.Type)
+	assert.Equal(t, notifications.SecureValue("secret"), destination.Auth.Token.Token)
+	require.Len(t, destination.Properties, 1)
+	assert.Equal(t, "url", destination.Properties[0].Key)
+
+	channel := expandNotificationChannel(children.Channel.Data)
+	assert.Equal(t, "destination-id", channel.DestinationId)
+	assert.Equal(t, notifications.AiNotificationsChannelTypeTypes.WEBHOOK, channel.Type)
+	assert.Equal(t, notifications.AiNotificationsProductTypes.IINT, channel.Product)
+	require.Len(t, channel.Properties, 1)
+	assert.Equal(t, "payload", channel.Properties[0].Key)
+
+	workflow, err := expandWorkflow(children.Workflow.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "checkout", workflow.Name)
+	assert.True(t, workflow.WorkflowEnabled)
+	assert.Equal(t, workflows.AiWorkflowsMutingRulesHandlingTypes.NOTIFY_ALL_ISSUES, workflow.MutingRulesHandling)
+	require.Len(t, workflow.DestinationConfigurations, 1)
+	assert.Equal(t, "channel-id", workflow.DestinationConfigurations[0].ChannelId)
+	assert.Equal(t, []workflows.AiWorkflowsNotificationTrigger{"ACTIVATED"}, workflow.DestinationConfigurations[0].NotificationTriggers)
+	require.Len(t, workflow.IssuesFilter.Predicates, 1)
+	assert.Equal(t, alertRoutingPolicyIDsAttribute, workflow.IssuesFilter.Predicates[0].Attribute)
+	assert.ElementsMatch(t, []string{"123", "456"}, workflow.IssuesFilter.Predicates[0].Values)
+	require.Len(t, workflow.Enrichments.NRQL, 1)
+
+	require.NoError(t, setAlertRoutingWorkflowChannel(children.Workflow.Data, "other-channel-id"))
+	assert.Equal(t, "other-channel-id", alertRoutingWorkflowChannelID(children.Workflow.Data))
+}
+
+func TestNewAlertRoutingChildren_ChannelType(t *testing.T) {
+	d := testAlertRoutingResourceData(t)
+	require.NoError(t, d.Set("destination", []interface{}{
+		map[string]interface{}{"type": "SERVICE_NOW", "property": []interface{}{}},
+	}))
+
+	children, err := newAlertRoutingChildren(d.Get, 1234, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "SERVICENOW_INCIDENTS", children.Channel.Data.Get("type"))
+
+	require.NoError(t, d.Set("channel", []interface{}{
+		map[string]interface{}{"type": "WEBHOOK", "product": "IINT", "property": []interface{}{}},
+	}))
+
+	children, err = newAlertRoutingChildren(d.Get, 1234, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "WEBHOOK", children.Channel.Data.Get("type"))
+}
+
+func TestFlattenAlertRouting(t *testing.T) {
+	children, err := newAlertRoutingChildren(testAlertRoutingResourceData(t).Get, 1234, "destination-id", "channel-id")
+	require.NoError(t, err)
+	children.Workflow.Data.SetId("workflow-id")
+
+	d := resourceNewRelicAlertRouting().TestResourceData()
+	require.NoError(t, flattenAlertRouting(children, d))
+
+	assert.Equal(t, 1234, d.Get("account_id"))
+	assert.Equal(t, "destination-id", d.Get("destination_id"))
+	assert.Equal(t, "channel-id", d.Get("channel_id"))
+	assert.Equal(t, "WEBHOOK", d.Get("destination.0.type"))
+	assert.Equal(t, "secret", d.Get("destination.0.auth_token.0.token"))
+	assert.Equal(t, "WEBHOOK", d.Get("channel.0.type"))
+	assert.ElementsMatch(t, []interface{}{"123", "456"}, d.Get("policy_ids").(*schema.Set).List())
+	assert.Equal(t, []interface{}{"ACTIVATED"}, d.Get("notification_triggers"))
+	assert.Equal(t, 1, d.Get("enrichments").(*schema.Set).Len())
+}
+
+func TestFlattenAlertRouting_ServerProperties(t *testing.T) {
+	children, err := newAlertRoutingChildren(testAlertRoutingResourceData(t).Get, 1234, "destination-id", "channel-id")
+	require.NoError(t, err)
+	children.Workflow.Data.SetId("workflow-id")
+
+	// Properties populated by the API when creating the destination and channel
+	require.NoError(t, children.Destination.Data.Set("property", []interface{}{
+		map[string]interface{}{"key": "url", "value": "https://example.com/hook"},
+		map[string]interface{}{"key": "headers", "value": "{}"},
+	}))
+	require.NoError(t, children.Channel.Data.Set("property", []interface{}{
+		map[string]interface{}{"key": "payload", "value": "{}"},
+		map[string]interface{}{"key": "headers", "value": "{}"},
+	}))
+
+	// Imported alert routings keep every property
+	d := resourceNewRelicAlertRouting().TestResourceData()
+	require.NoError(t, flattenAlertRouting(children, d))
+	assert.Equal(t, 2, d.Get("destination.0.property").(*schema.Set).Len())
+	assert.Equal(t, 2, d.Get("channel.0.property").(*schema.Set).Len())
+
+	d = testAlertRoutingResourceData(t)
+	require.NoError(t, flattenAlertRouting(children, d))
+
+	destinationProperties := d.Get("destination.0.property").(*schema.Set).List()
+	require.Len(t, destinationProperties, 1)
+	assert.Equal(t, "url", destinationProperties[0].(map[string]interface{})["key"])
+
+	// A property changed outside of Terraform shows as drift
+	channelProperties := d.Get("channel.0.property").(*schema.Set).List()
+	require.Len(t, channelProperties, 1)
+	assert.Equal(t, "payload", channelProperties[0].(map[string]interface{})["key"])
+	assert.Equal(t, "{}", channelProperties[0].(map[string]interface{})["value"])
+}
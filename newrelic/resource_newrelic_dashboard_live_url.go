@@ -0,0 +1,123 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNewRelicDashboardLiveURL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNewRelicDashboardLiveURLCreate,
+		ReadContext:   resourceNewRelicDashboardLiveURLRead,
+		DeleteContext: resourceNewRelicDashboardLiveURLDelete,
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the dashboard to share, or of the dashboard page of the shared widget.",
+			},
+			"widget_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the widget to share. The whole dashboard is shared when not set.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The live URL, which can be opened without logging in to New Relic.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the live URL, DASHBOARD or WIDGET.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The title of the shared dashboard or widget.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the live URL was created, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func resourceNewRelicDashboardLiveURLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	guid := d.Get("guid").(string)
+	widgetID := d.Get("widget_id").(string)
+
+	log.Printf("[INFO] Creating New Relic dashboard live URL for %s", guid)
+
+	liveURL, err := createDashboardLiveURL(ctx, client, guid, widgetID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(liveURL.UUID)
+
+	return diag.FromErr(flattenDashboardLiveURL(liveURL, d))
+}
+
+func resourceNewRelicDashboardLiveURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Reading New Relic dashboard live URL %s", d.Id())
+
+	liveURLType := dashboardLiveURLTypeDashboard
+	if d.Get("widget_id").(string) != "" {
+		liveURLType = dashboardLiveURLTypeWidget
+	}
+
+	liveURL, err := getDashboardLiveURL(ctx, client, liveURLType, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Live URLs revoked outside of Terraform are created again
+	if liveURL == nil {
+		log.Printf("[WARN] New Relic dashboard live URL %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return diag.FromErr(flattenDashboardLiveURL(liveURL, d))
+}
+
+func resourceNewRelicDashboardLiveURLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Revoking New Relic dashboard live URL %s", d.Id())
+
+	return diag.FromErr(revokeDashboardLiveURL(ctx, client, d.Id()))
+}
+
+func flattenDashboardLiveURL(liveURL *dashboardLiveURL, d *schema.ResourceData) error {
+	if err := d.Set("url", liveURL.URL); err != nil {
+		return err
+	}
+
+	if err := d.Set("type", liveURL.Type); err != nil {
+		return err
+	}
+
+	if err := d.Set("title", liveURL.Title); err != nil {
+		return err
+	}
+
+	if liveURL.CreatedAt > 0 {
+		return d.Set("created_at", time.UnixMilli(liveURL.CreatedAt).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+//go:build integration
+// +build integration
+
+package newrelic
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewRelicDashboardLiveURL_Basic(t *testing.T) {
+	resourceName := "newrelic_dashboard_live_url.foo"
+	rName := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicDashboardLiveURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNewRelicDashboardLiveURLConfig(rName, strconv.Itoa(testAccountID)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "DASHBOARD"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile(`^https://`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNewRelicDashboardLiveURLDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderConfig).NewClient
+
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "newrelic_dashboard_live_url" {
+			continue
+		}
+
+		liveURL, err := getDashboardLiveURL(context.Background(), client, dashboardLiveURLTypeDashboard, r.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if liveURL != nil {
+			return fmt.Errorf("dashboard live URL still exists: %s", r.Primary.ID)
+		}
+	}
+
+	return testAccCheckNewRelicOneDashboardDestroy(s)
+}
+
+func testAccNewRelicDashboardLiveURLConfig(dashboardName string, accountID string) string {
+	return testAccCheckNewRelicOneDashboardConfig_TwoPageBasic(dashboardName, accountID) + `
+
+resource "newrelic_dashboard_live_url" "foo" {
+  guid = newrelic_one_dashboard.bar.guid
+}
+`
+}
@@ -0,0 +1,44 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenDashboardLiveURL(t *testing.T) {
+	r := resourceNewRelicDashboardLiveURL()
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"guid":      "MXxWSVp8REFTSEJPQVJEfDI",
+		"widget_id": "12345",
+	})
+
+	require.NoError(t, flattenDashboardLiveURL(&dashboardLiveURL{
+		CreatedAt: 1791763200000,
+		Title:     "Throughput",
+		Type:      dashboardLiveURLTypeWidget,
+		URL:       "https://chart-embed.service.newrelic.com/herald/abc",
+		UUID:      "abc",
+	}, d))
+
+	require.Equal(t, "https://chart-embed.service.newrelic.com/herald/abc", d.Get("url"))
+	require.Equal(t, "WIDGET", d.Get("type"))
+	require.Equal(t, "Throughput", d.Get("title"))
+	require.Equal(t, "2026-10-12T00:00:00Z", d.Get("created_at"))
+}
+
+func TestDashboardLiveURLErrors(t *testing.T) {
+	require.NoError(t, dashboardLiveURLErrors("creating", nil))
+
+	err := dashboardLiveURLErrors("revoking", []dashboardLiveURLError{
+		{Type: "NOT_FOUND", Description: "Live URL not found"},
+		{Type: "FORBIDDEN", Description: "Not allowed"},
+	})
+	require.EqualError(t, err, "error revoking live URL: NOT_FOUND: Live URL not found, FORBIDDEN: Not allowed")
+}
@@ -64,7 +64,7 @@ func resourceNewRelicInfraAlertCondition() *schema.Resource {
 		UpdateContext:      resourceNewRelicInfraAlertConditionUpdate,
 		DeleteContext:      resourceNewRelicInfraAlertConditionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithEntityGUID("AIOPS", "CONDITION", resourceNewRelicInfraAlertConditionImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
@@ -205,6 +205,25 @@ func resourceNewRelicInfraAlertConditionCreate(ctx context.Context, d *schema.Re
 	return resourceNewRelicInfraAlertConditionRead(ctx, d, meta)
 }
 
+// Resolves the policy ID of the infrastructure alert condition identified by an entity GUID.
+func resourceNewRelicInfraAlertConditionImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+
+	conditionID, err := strconv.Atoi(guid.Identifier)
+	if err != nil {
+		return err
+	}
+
+	condition, err := client.Alerts.GetInfrastructureConditionWithContext(ctx, conditionID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serializeIDs([]int{condition.PolicyID, condition.ID}))
+
+	return nil
+}
+
 func resourceNewRelicInfraAlertConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := meta.(*ProviderConfig).NewClient
@@ -10,6 +10,7 @@ import (
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/errors"
@@ -24,6 +25,10 @@ func resourceNewRelicNotificationChannel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			notificationChannelTypedBlocks.customizeDiff("channel"),
+			customizeDiffNotificationChannelTest,
+		),
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -58,17 +63,29 @@ func resourceNewRelicNotificationChannel() *schema.Resource {
 				Description:  fmt.Sprintf("(Required) The type of the channel product. One of: (%s).", strings.Join(listValidNotificationsProductTypes(), ", ")),
 			},
 			"property": {
-				Type:        schema.TypeSet,
-				Required:    true,
-				Description: "Notification channel property type.",
-				Elem:        notificationsPropertySchema(),
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: append([]string{"property"}, notificationChannelTypedBlocks.names()...),
+				Description:  "Notification channel property type.",
+				Elem:         notificationChannelPropertySchema(),
 			},
+			"slack":             notificationChannelTypedBlocks.schema("slack"),
+			"pagerduty_service": notificationChannelTypedBlocks.schema("pagerduty_service"),
+			"webhook":           notificationChannelTypedBlocks.schema("webhook"),
+			"jira":              notificationChannelTypedBlocks.schema("jira"),
+			"servicenow":        notificationChannelTypedBlocks.schema("servicenow"),
 			"active": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Indicates whether the channel is active.",
 				Default:     true,
 			},
+			"send_test_notification": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to send a test notification through the channel after it is created or updated.",
+			},
 
 			// Computed
 			"status": {
@@ -76,6 +93,11 @@ func resourceNewRelicNotificationChannel() *schema.Resource {
 				Computed:    true,
 				Description: "The status of the channel.",
 			},
+			"last_test_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The result of the last test notification sent through the channel.",
+			},
 		},
 	}
 }
@@ -110,7 +132,9 @@ func resourceNewRelicNotificationChannelCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(channelResponse.Channel.ID)
 
-	return resourceNewRelicNotificationChannelRead(updatedContext, d, meta)
+	diags := sendNotificationChannelTest(updatedContext, d, meta, accountID)
+
+	return append(diags, resourceNewRelicNotificationChannelRead(updatedContext, d, meta)...)
 }
 
 func resourceNewRelicNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -153,6 +177,7 @@ func resourceNewRelicNotificationChannelUpdate(ctx context.Context, d *schema.Re
 	accountID := selectAccountID(providerConfig, d)
 	updatedContext := updateContextWithAccountID(ctx, accountID)
 	updateInput := expandNotificationChannelUpdate(d)
+	updateInput.Properties = append(updateInput.Properties, createMonitoringProperty())
 
 	channelResponse, err := client.Notifications.AiNotificationsUpdateChannelWithContext(updatedContext, accountID, updateInput, d.Id())
 	if err != nil {
@@ -164,7 +189,51 @@ func resourceNewRelicNotificationChannelUpdate(ctx context.Context, d *schema.Re
 		return errors
 	}
 
-	return resourceNewRelicNotificationChannelRead(updatedContext, d, meta)
+	diags := sendNotificationChannelTest(updatedContext, d, meta, accountID)
+
+	return append(diags, resourceNewRelicNotificationChannelRead(updatedContext, d, meta)...)
+}
+
+// Sends a test notification through the channel when `send_test_notification` is enabled,
+// recording its result in `last_test_status`.
+func sendNotificationChannelTest(ctx context.Context, d *schema.ResourceData, meta interface{}, accountID int) diag.Diagnostics {
+	if !d.Get("send_test_notification").(bool) {
+		return nil
+	}
+
+	client := meta.(*ProviderConfig).NewClient
+
+	log.Printf("[INFO] Sending test notification through New Relic notification channel %s", d.Id())
+
+	result, err := testNotificationChannel(ctx, client, accountID, d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("could not send test notification for channel %s", d.Id()),
+				Detail:   err.Error(),
+			},
+		}
+	}
+
+	if err := d.Set("last_test_status", result.Result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return buildNotificationChannelTestDiagnostics(d.Id(), result)
+}
+
+// Marks `last_test_status` as unknown when a test notification is sent on apply.
+func customizeDiffNotificationChannelTest(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("send_test_notification").(bool) {
+		return nil
+	}
+
+	if d.Id() == "" || len(d.GetChangedKeysPrefix("")) > 0 {
+		return d.SetNewComputed("last_test_status")
+	}
+
+	return nil
 }
 
 func resourceNewRelicNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -196,6 +265,60 @@ func resourceNewRelicNotificationChannelDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
+// Typed blocks expanding to the properties of the corresponding channel types.
+var notificationChannelTypedBlocks = notificationsTypedBlocks{
+	{
+		Name:        "slack",
+		Description: "Slack channel message.",
+		Types: []string{
+			string(notifications.AiNotificationsChannelTypeTypes.SLACK),
+			string(notifications.AiNotificationsChannelTypeTypes.SLACK_COLLABORATION),
+		},
+		Fields: []notificationsTypedField{
+			{Attribute: "channel_id", Key: "channelId", Required: true, Description: "The ID of the Slack channel."},
+			{Attribute: "custom_details", Key: "customDetailsSlack", Template: true, Description: "Custom details added to the message, compatible with the Slack blocks API."},
+		},
+	},
+	{
+		Name:        "pagerduty_service",
+		Description: "PagerDuty service integration alert.",
+		Types:       []string{string(notifications.AiNotificationsChannelTypeTypes.PAGERDUTY_SERVICE_INTEGRATION)},
+		Fields: []notificationsTypedField{
+			{Attribute: "summary", Key: "summary", Required: true, Template: true, Description: "The summary of the alert."},
+			{Attribute: "custom_details", Key: "customDetails", Template: true, JSON: true, Description: "A JSON template replacing the content of the alert."},
+		},
+	},
+	{
+		Name:        "webhook",
+		Description: "Webhook request.",
+		Types:       []string{string(notifications.AiNotificationsChannelTypeTypes.WEBHOOK)},
+		Fields: []notificationsTypedField{
+			{Attribute: "payload", Key: "payload", Required: true, Template: true, JSON: true, Description: "The JSON template of the request payload."},
+			{Attribute: "headers", Key: "headers", Template: true, JSON: true, Description: "The JSON template of the request headers."},
+		},
+	},
+	{
+		Name:        "jira",
+		Description: "Jira issue.",
+		Types:       []string{string(notifications.AiNotificationsChannelTypeTypes.JIRA_CLASSIC)},
+		Fields: []notificationsTypedField{
+			{Attribute: "project", Key: "project", Required: true, Description: "The ID of the Jira project."},
+			{Attribute: "issue_type", Key: "issuetype", Required: true, Description: "The ID of the issue type."},
+			{Attribute: "summary", Key: "summary", Required: true, Template: true, Description: "The summary of the issue."},
+			{Attribute: "description", Key: "description", Required: true, Template: true, Description: "The description of the issue."},
+		},
+	},
+	{
+		Name:        "servicenow",
+		Description: "ServiceNow incident.",
+		Types:       []string{string(notifications.AiNotificationsChannelTypeTypes.SERVICENOW_INCIDENTS)},
+		Fields: []notificationsTypedField{
+			{Attribute: "short_description", Key: "short_description", Template: true, Description: "The short description of the incident."},
+			{Attribute: "description", Key: "description", Template: true, Description: "The description of the incident."},
+		},
+	},
+}
+
 // Validation function to validate allowed channel types
 func listValidNotificationsChannelTypes() []string {
 	return []string{
@@ -24,6 +24,7 @@ func resourceNewRelicNotificationDestination() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: notificationDestinationTypedBlocks.customizeDiff("destination"),
 		Schema: map[string]*schema.Schema{
 			"account_id": {
 				Type:        schema.TypeInt,
@@ -45,11 +46,17 @@ func resourceNewRelicNotificationDestination() *schema.Resource {
 				Description:  fmt.Sprintf("(Required) The type of the destination. One of: (%s).", strings.Join(listValidNotificationsDestinationTypes(), ", ")),
 			},
 			"property": {
-				Type:        schema.TypeSet,
-				Required:    true,
-				Description: "Notification destination property type.",
-				Elem:        notificationsPropertySchema(),
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: append([]string{"property"}, notificationDestinationTypedBlocks.names()...),
+				Description:  "Notification destination property type.",
+				Elem:         notificationsPropertySchema(),
 			},
+			"slack":             notificationDestinationTypedBlocks.schema("slack"),
+			"pagerduty_service": notificationDestinationTypedBlocks.schema("pagerduty_service"),
+			"webhook":           notificationDestinationTypedBlocks.schema("webhook"),
+			"jira":              notificationDestinationTypedBlocks.schema("jira"),
+			"servicenow":        notificationDestinationTypedBlocks.schema("servicenow"),
 			"auth_basic": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -346,6 +353,53 @@ func resourceNewRelicNotificationDestinationDelete(ctx context.Context, d *schem
 	return nil
 }
 
+// Typed blocks expanding to the properties of the corresponding destination types.
+var notificationDestinationTypedBlocks = notificationsTypedBlocks{
+	{
+		Name:        "slack",
+		Description: "Slack incoming webhook destination.",
+		Types:       []string{string(notifications.AiNotificationsDestinationTypeTypes.SLACK_LEGACY)},
+		Fields: []notificationsTypedField{
+			{Attribute: "url", Key: "url", Required: true, Description: "The Slack incoming webhook URL."},
+		},
+	},
+	{
+		Name:          "pagerduty_service",
+		Description:   "PagerDuty service integration destination.",
+		Types:         []string{string(notifications.AiNotificationsDestinationTypeTypes.PAGERDUTY_SERVICE_INTEGRATION)},
+		ConflictsWith: []string{"auth_basic", "auth_token"},
+		Fields: []notificationsTypedField{
+			{Attribute: "service_key", Required: true, Sensitive: true, Description: "The integration key of the PagerDuty service."},
+		},
+	},
+	{
+		Name:        "webhook",
+		Description: "Webhook destination.",
+		Types:       []string{string(notifications.AiNotificationsDestinationTypeTypes.WEBHOOK)},
+		Fields: []notificationsTypedField{
+			{Attribute: "url", Key: "url", Required: true, Description: "The webhook URL."},
+		},
+	},
+	{
+		Name:        "jira",
+		Description: "Jira destination.",
+		Types:       []string{string(notifications.AiNotificationsDestinationTypeTypes.JIRA)},
+		Fields: []notificationsTypedField{
+			{Attribute: "url", Key: "url", Required: true, Description: "The base URL of the Jira instance."},
+			{Attribute: "two_way_integration", Key: "two_way_integration", Bool: true, Description: "Whether the two-way integration is enabled."},
+		},
+	},
+	{
+		Name:        "servicenow",
+		Description: "ServiceNow destination.",
+		Types:       []string{string(notifications.AiNotificationsDestinationTypeTypes.SERVICE_NOW)},
+		Fields: []notificationsTypedField{
+			{Attribute: "url", Key: "url", Required: true, Description: "The base URL of the ServiceNow instance."},
+			{Attribute: "two_way_integration", Key: "two_way_integration", Bool: true, Description: "Whether the two-way integration is enabled."},
+		},
+	},
+}
+
 // Validation function to validate allowed destination types
 func listValidNotificationsDestinationTypes() []string {
 	return []string{
@@ -74,6 +74,30 @@ func termSchema() *schema.Resource {
 				Optional:    true,
 				Description: "The duration, in seconds, that the threshold must violate in order to create an incident. Value must be a multiple of the 'aggregation_window' (which has a default of 60 seconds). Value must be within 120-86400 seconds for baseline conditions, and within 60-86400 seconds for static conditions",
 			},
+			// NerdGraph only. Static conditions only.
+			"prediction": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Open an incident when the threshold is predicted to be breached in the future. Only available for static conditions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"predict_by": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3600,
+							Description:  "The duration, in seconds, that the prediction should look into the future. Defaults to 3600 seconds (1 hour).",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"prefer_prediction_violation": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to keep an open prediction incident open, rather than opening a static incident, when the actual signal breaches the threshold. Defaults to false.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -91,6 +115,9 @@ func termSchemaDeprecated() *schema.Resource {
 
 	rec.Schema["priority"] = prioritySchema
 
+	// Predictions are only supported on the named `critical` and `warning` terms.
+	delete(rec.Schema, "prediction")
+
 	return rec
 }
 
@@ -101,7 +128,7 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 		UpdateContext: resourceNewRelicNrqlAlertConditionUpdate,
 		DeleteContext: resourceNewRelicNrqlAlertConditionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceImportStateWithMetadata(2, "type"),
+			StateContext: resourceImportStateWithEntityGUID("AIOPS", "CONDITION", resourceNewRelicNrqlAlertConditionImportGUID, resourceImportStateWithMetadata(2, "type")),
 		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
@@ -222,6 +249,12 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 				Optional:    true,
 				Description: "The description of the NRQL alert condition.",
 			},
+			"title_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The custom title to be used when incidents are opened by the condition. Setting this field will override the default title. Must be in Handlebars format.",
+				ValidateFunc: validateNrqlConditionTitleTemplate,
+			},
 			"violation_time_limit": {
 				Type:          schema.TypeString,
 				Deprecated:    "use `violation_time_limit_seconds` attribute instead",
@@ -244,6 +277,12 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 				Optional:    true,
 				Description: "Whether to close all open incidents when the signal expires.",
 			},
+			"ignore_on_expected_termination": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether an alert condition should ignore expected termination of a signal when considering whether to create a loss of signal incident. Defaults to false.",
+			},
 			"aggregation_window": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -349,10 +388,28 @@ func resourceNewRelicNrqlAlertCondition() *schema.Resource {
 					return strings.EqualFold(old, new) // Case fold this attribute when diffing
 				},
 			},
+			"signal_seasonality": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The seasonality used to calculate the baseline of a baseline NRQL alert condition. Valid values are: 'NEW_RELIC_CALCULATION', 'HOURLY', 'DAILY', 'WEEKLY', 'NONE' (case insensitive).",
+				ValidateFunc: validation.StringInSlice(nrqlConditionSignalSeasonalities, true),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new) // Case fold this attribute when diffing
+				},
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceNewRelicNrqlAlertConditionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceNewRelicNrqlAlertConditionStateUpgradeV0,
+				Version: 0,
+			},
+		},
 	}
 }
 
@@ -368,56 +425,24 @@ func resourceNewRelicNrqlAlertConditionCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[INFO] Creating New Relic NRQL alert condition %s via NerdGraph API", conditionInput.Name)
-
-	var condition *alerts.NrqlAlertCondition
-
-	switch d.Get("type").(string) {
-	case "baseline":
-		condition, err = client.Alerts.CreateNrqlConditionBaselineMutationWithContext(ctx, accountID, policyID, *conditionInput)
-	case "static":
-		condition, err = client.Alerts.CreateNrqlConditionStaticMutationWithContext(ctx, accountID, policyID, *conditionInput)
+	extendedFields, err := expandNrqlConditionExtendedFields(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
-
-	if graphQLError, ok := err.(*alerts.GraphQLErrorResponse); ok {
-		for _, e := range graphQLError.Errors {
-			var message string = e.Message
-			var errorClass string = e.Extensions.ErrorClass
-			var validationErrors = e.Extensions.ValidationErrors
+	log.Printf("[INFO] Creating New Relic NRQL alert condition %s via NerdGraph API", conditionInput.Name)
 
-			if len(validationErrors) == 0 {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  message + ": " + errorClass,
-				})
-			} else {
-				for _, validationError := range validationErrors {
-					diags = append(diags, diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  message + ": " + errorClass,
-						Detail:   validationError.Name + ": " + validationError.Reason,
-					})
-				}
-			}
-		}
+	conditionID, err := createNrqlCondition(ctx, client, accountID, policyID, d.Get("type").(string), conditionInput, extendedFields)
 
-		return diags
+	if graphQLError, ok := err.(*alerts.GraphQLErrorResponse); ok {
+		return buildNrqlConditionGraphQLErrors(graphQLError)
 	}
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if condition == nil {
-		return diag.Errorf("error creating nrql alert condition: response was nil")
-	}
-
-	conditionID, err := strconv.Atoi(condition.ID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	var condition *alerts.NrqlAlertCondition
 
 	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		condition, err = client.Alerts.GetNrqlConditionQueryWithContext(ctx, accountID, strconv.Itoa(conditionID))
@@ -437,7 +462,34 @@ func resourceNewRelicNrqlAlertConditionCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(serializeIDs([]int{d.Get("policy_id").(int), conditionID})) // set to correct ID
 
-	return diag.FromErr(flattenNrqlAlertCondition(accountID, condition, d))
+	if err = flattenNrqlAlertCondition(accountID, condition, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	extendedFields, err = getNrqlConditionExtendedFields(ctx, client, accountID, strconv.Itoa(conditionID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenNrqlConditionExtendedFields(d, extendedFields))
+}
+
+// Resolves the policy ID and type of the NRQL alert condition identified by an entity GUID.
+func resourceNewRelicNrqlAlertConditionImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+
+	condition, err := client.Alerts.GetNrqlConditionQueryWithContext(ctx, guid.AccountID, guid.Identifier)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", condition.PolicyID, condition.ID))
+
+	if err := d.Set("account_id", guid.AccountID); err != nil {
+		return err
+	}
+
+	return d.Set("type", strings.ToLower(string(condition.Type)))
 }
 
 func resourceNewRelicNrqlAlertConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -473,7 +525,16 @@ func resourceNewRelicNrqlAlertConditionRead(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	return diag.FromErr(flattenNrqlAlertCondition(accountID, nrqlCondition, d))
+	if err = flattenNrqlAlertCondition(accountID, nrqlCondition, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	extendedFields, err := getNrqlConditionExtendedFields(ctx, client, accountID, strconv.Itoa(conditionID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenNrqlConditionExtendedFields(d, extendedFields))
 }
 
 func resourceNewRelicNrqlAlertConditionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -493,38 +554,19 @@ func resourceNewRelicNrqlAlertConditionUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	switch d.Get("type").(string) {
-	case "baseline":
-		_, err = client.Alerts.UpdateNrqlConditionBaselineMutationWithContext(ctx, accountID, conditionID, *conditionInput)
-	case "static":
-		_, err = client.Alerts.UpdateNrqlConditionStaticMutationWithContext(ctx, accountID, conditionID, *conditionInput)
+	extendedFields, err := expandNrqlConditionExtendedFields(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	var diags diag.Diagnostics
+	err = updateNrqlCondition(ctx, client, accountID, conditionID, d.Get("type").(string), conditionInput, extendedFields)
 
 	if graphQLError, ok := err.(*alerts.GraphQLErrorResponse); ok {
-		for _, e := range graphQLError.Errors {
-			var message string = e.Message
-			var errorClass string = e.Extensions.ErrorClass
-			var validationErrors = e.Extensions.ValidationErrors
-
-			if len(validationErrors) == 0 {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  message + ": " + errorClass,
-				})
-			} else {
-				for _, validationError := range validationErrors {
-					diags = append(diags, diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  message + ": " + errorClass,
-						Detail:   validationError.Name + ": " + validationError.Reason,
-					})
-				}
-			}
-		}
+		return buildNrqlConditionGraphQLErrors(graphQLError)
+	}
 
-		return diags
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceNewRelicNrqlAlertConditionRead(ctx, d, meta)
@@ -551,3 +593,31 @@ func resourceNewRelicNrqlAlertConditionDelete(ctx context.Context, d *schema.Res
 
 	return nil
 }
+
+// Builds diagnostics out of the errors and validation errors of a NRQL condition mutation.
+func buildNrqlConditionGraphQLErrors(graphQLError *alerts.GraphQLErrorResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range graphQLError.Errors {
+		var message string = e.Message
+		var errorClass string = e.Extensions.ErrorClass
+		var validationErrors = e.Extensions.ValidationErrors
+
+		if len(validationErrors) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  message + ": " + errorClass,
+			})
+		} else {
+			for _, validationError := range validationErrors {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  message + ": " + errorClass,
+					Detail:   validationError.Name + ": " + validationError.Reason,
+				})
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,215 @@
+package newrelic
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Maps the deprecated `violation_time_limit` values to `violation_time_limit_seconds`.
+var violationTimeLimitSecondsMap = map[string]int{
+	"ONE_HOUR":          3600,
+	"TWO_HOURS":         7200,
+	"FOUR_HOURS":        14400,
+	"EIGHT_HOURS":       28800,
+	"TWELVE_HOURS":      43200,
+	"TWENTY_FOUR_HOURS": 86400,
+	"THIRTY_DAYS":       2592000,
+}
+
+// Maps the deprecated `time_function` values to `threshold_occurrences`, as stored in state.
+var timeFunctionThresholdOccurrencesMap = map[string]string{
+	"all": "all",
+	"any": "at_least_once",
+}
+
+// resourceNewRelicNrqlAlertConditionV0 is the schema of version 0 of the NRQL alert condition
+// resource. It only describes attribute types and must not change, since it is used to decode
+// state written by provider versions which still supported the deprecated attributes.
+func resourceNewRelicNrqlAlertConditionV0() *schema.Resource {
+	termV0 := func(withPriority bool) *schema.Resource {
+		term := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration":              {Type: schema.TypeInt, Optional: true},
+				"operator":              {Type: schema.TypeString, Optional: true},
+				"threshold":             {Type: schema.TypeFloat, Required: true},
+				"time_function":         {Type: schema.TypeString, Optional: true},
+				"threshold_occurrences": {Type: schema.TypeString, Optional: true},
+				"threshold_duration":    {Type: schema.TypeInt, Optional: true},
+			},
+		}
+
+		if withPriority {
+			term.Schema["priority"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+		} else {
+			term.Schema["prediction"] = &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"predict_by":                  {Type: schema.TypeInt, Optional: true},
+						"prefer_prediction_violation": {Type: schema.TypeBool, Optional: true},
+					},
+				},
+			}
+		}
+
+		return term
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"policy_id":   {Type: schema.TypeInt, Required: true},
+			"name":        {Type: schema.TypeString, Required: true},
+			"runbook_url": {Type: schema.TypeString, Optional: true},
+			"enabled":     {Type: schema.TypeBool, Optional: true},
+			"type":        {Type: schema.TypeString, Optional: true},
+			"nrql": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query":             {Type: schema.TypeString, Required: true},
+						"since_value":       {Type: schema.TypeString, Optional: true},
+						"evaluation_offset": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+			"term":                           {Type: schema.TypeSet, Optional: true, Elem: termV0(true)},
+			"critical":                       {Type: schema.TypeList, Optional: true, Elem: termV0(false)},
+			"warning":                        {Type: schema.TypeList, Optional: true, Elem: termV0(false)},
+			"violation_time_limit_seconds":   {Type: schema.TypeInt, Optional: true},
+			"account_id":                     {Type: schema.TypeInt, Optional: true, Computed: true},
+			"description":                    {Type: schema.TypeString, Optional: true},
+			"title_template":                 {Type: schema.TypeString, Optional: true},
+			"violation_time_limit":           {Type: schema.TypeString, Optional: true, Computed: true},
+			"open_violation_on_expiration":   {Type: schema.TypeBool, Optional: true},
+			"close_violations_on_expiration": {Type: schema.TypeBool, Optional: true},
+			"ignore_on_expected_termination": {Type: schema.TypeBool, Optional: true},
+			"aggregation_window":             {Type: schema.TypeInt, Optional: true, Computed: true},
+			"slide_by":                       {Type: schema.TypeInt, Optional: true},
+			"expiration_duration":            {Type: schema.TypeInt, Optional: true},
+			"fill_option":                    {Type: schema.TypeString, Optional: true},
+			"fill_value":                     {Type: schema.TypeFloat, Optional: true},
+			"aggregation_method":             {Type: schema.TypeString, Optional: true},
+			"aggregation_delay":              {Type: schema.TypeString, Optional: true},
+			"evaluation_delay":               {Type: schema.TypeInt, Optional: true},
+			"aggregation_timer":              {Type: schema.TypeString, Optional: true},
+			"entity_guid":                    {Type: schema.TypeString, Computed: true},
+			"baseline_direction":             {Type: schema.TypeString, Optional: true},
+			"signal_seasonality":             {Type: schema.TypeString, Optional: true, Computed: true},
+		},
+	}
+}
+
+// Upgrades version 0 of the NRQL alert condition state by adding the replacements of the
+// deprecated attributes alongside them, the deprecated attributes are kept until they are removed
+// from the schema, since conditions are read back in the shape of the attributes stored in state:
+//
+//   - `duration` (minutes) is completed with `threshold_duration` (seconds)
+//   - `time_function` is completed with `threshold_occurrences`
+//   - `nrql.since_value` and `nrql.evaluation_offset` are completed with the `cadence` aggregation
+//     method and an `aggregation_delay` covering the same number of aggregation windows
+//   - `violation_time_limit` is completed with `violation_time_limit_seconds`
+//
+// `term` blocks are kept as they are, `critical` and `warning` blocks conflict with them and a copy
+// of the terms in those would show as removed in the plan of every configuration using `term`.
+func resourceNewRelicNrqlAlertConditionStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	log.Printf("[INFO] Upgrading state of New Relic NRQL alert condition %v to schema version 1", rawState["id"])
+
+	upgradeNrqlConditionTermsV0(rawState)
+	upgradeNrqlConditionEvaluationOffsetV0(rawState)
+	upgradeNrqlConditionViolationTimeLimitV0(rawState)
+
+	return rawState, nil
+}
+
+func upgradeNrqlConditionTermsV0(rawState map[string]interface{}) {
+	for _, attr := range []string{"term", "critical", "warning"} {
+		terms, _ := rawState[attr].([]interface{})
+		for _, t := range terms {
+			if term, ok := t.(map[string]interface{}); ok {
+				upgradeNrqlConditionTermV0(term)
+			}
+		}
+	}
+}
+
+func upgradeNrqlConditionTermV0(term map[string]interface{}) {
+	if duration := stateInt(term["duration"]); duration > 0 && stateInt(term["threshold_duration"]) == 0 {
+		term["threshold_duration"] = duration * 60
+	}
+
+	if timeFunction := stateString(term["time_function"]); timeFunction != "" && stateString(term["threshold_occurrences"]) == "" {
+		term["threshold_occurrences"] = timeFunctionThresholdOccurrencesMap[strings.ToLower(timeFunction)]
+	}
+}
+
+func upgradeNrqlConditionEvaluationOffsetV0(rawState map[string]interface{}) {
+	nrql, _ := rawState["nrql"].([]interface{})
+	if len(nrql) == 0 || stateString(rawState["aggregation_method"]) != "" {
+		return
+	}
+
+	query, ok := nrql[0].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	evaluationOffset := stateInt(query["evaluation_offset"])
+	if sinceValue, err := strconv.Atoi(stateString(query["since_value"])); err == nil && sinceValue > 0 {
+		evaluationOffset = sinceValue
+	}
+
+	if evaluationOffset == 0 {
+		return
+	}
+
+	aggregationWindow := stateInt(rawState["aggregation_window"])
+	if aggregationWindow == 0 {
+		aggregationWindow = 60
+	}
+
+	rawState["aggregation_method"] = "cadence"
+	rawState["aggregation_delay"] = strconv.Itoa(evaluationOffset * aggregationWindow)
+}
+
+func upgradeNrqlConditionViolationTimeLimitV0(rawState map[string]interface{}) {
+	if stateInt(rawState["violation_time_limit_seconds"]) > 0 {
+		return
+	}
+
+	if seconds, ok := violationTimeLimitSecondsMap[strings.ToUpper(stateString(rawState["violation_time_limit"]))]; ok {
+		rawState["violation_time_limit_seconds"] = seconds
+	}
+}
+
+// Reads an integer from a raw state value, which is a float64 when decoded from JSON.
+func stateInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+
+	return 0
+}
+
+func stateString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	return ""
+}
@@ -0,0 +1,199 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceNewRelicNrqlAlertConditionStateUpgradeV0(t *testing.T) {
+	cases := map[string]struct {
+		RawState map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		"deprecated terms": {
+			RawState: map[string]interface{}{
+				"id":                           "123:456",
+				"violation_time_limit_seconds": float64(259200),
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"query":             "SELECT count(*) FROM Transaction",
+						"evaluation_offset": float64(0),
+						"since_value":       "",
+					},
+				},
+				"term": []interface{}{
+					map[string]interface{}{
+						"operator":              "above",
+						"priority":              "critical",
+						"threshold":             float64(5),
+						"duration":              float64(5),
+						"time_function":         "all",
+						"threshold_duration":    float64(0),
+						"threshold_occurrences": "",
+					},
+					map[string]interface{}{
+						"operator":              "above",
+						"priority":              "warning",
+						"threshold":             float64(3),
+						"duration":              float64(0),
+						"time_function":         "",
+						"threshold_duration":    float64(600),
+						"threshold_occurrences": "at_least_once",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"id":                           "123:456",
+				"violation_time_limit_seconds": float64(259200),
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"query":             "SELECT count(*) FROM Transaction",
+						"evaluation_offset": float64(0),
+						"since_value":       "",
+					},
+				},
+				"term": []interface{}{
+					map[string]interface{}{
+						"operator":              "above",
+						"priority":              "critical",
+						"threshold":             float64(5),
+						"duration":              float64(5),
+						"time_function":         "all",
+						"threshold_duration":    300,
+						"threshold_occurrences": "all",
+					},
+					map[string]interface{}{
+						"operator":              "above",
+						"priority":              "warning",
+						"threshold":             float64(3),
+						"duration":              float64(0),
+						"time_function":         "",
+						"threshold_duration":    float64(600),
+						"threshold_occurrences": "at_least_once",
+					},
+				},
+			},
+		},
+		"deprecated attributes within critical term": {
+			RawState: map[string]interface{}{
+				"critical": []interface{}{
+					map[string]interface{}{
+						"operator":      "below",
+						"threshold":     float64(1),
+						"duration":      float64(10),
+						"time_function": "any",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"critical": []interface{}{
+					map[string]interface{}{
+						"operator":              "below",
+						"threshold":             float64(1),
+						"duration":              float64(10),
+						"time_function":         "any",
+						"threshold_duration":    600,
+						"threshold_occurrences": "at_least_once",
+					},
+				},
+			},
+		},
+		"since value": {
+			RawState: map[string]interface{}{
+				"aggregation_window": float64(60),
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"query":       "SELECT count(*) FROM Transaction",
+						"since_value": "3",
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"aggregation_window": float64(60),
+				"aggregation_method": "cadence",
+				"aggregation_delay":  "180",
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"query":       "SELECT count(*) FROM Transaction",
+						"since_value": "3",
+					},
+				},
+			},
+		},
+		"evaluation offset": {
+			RawState: map[string]interface{}{
+				"aggregation_window": float64(120),
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"query":             "SELECT count(*) FROM Transaction",
+						"evaluation_offset": float64(2),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"aggregation_window": float64(120),
+				"aggregation_method": "cadence",
+				"aggregation_delay":  "240",
+				"nrql": []interface{}{
+					map[string]interface{}{
+						"query":             "SELECT count(*) FROM Transaction",
+						"evaluation_offset": float64(2),
+					},
+				},
+			},
+		},
+		"violation time limit": {
+			RawState: map[string]interface{}{
+				"violation_time_limit":         "eight_hours",
+				"violation_time_limit_seconds": float64(0),
+			},
+			Expected: map[string]interface{}{
+				"violation_time_limit":         "eight_hours",
+				"violation_time_limit_seconds": 28800,
+			},
+		},
+		"violation time limit with seconds": {
+			RawState: map[string]interface{}{
+				"violation_time_limit":         "ONE_HOUR",
+				"violation_time_limit_seconds": float64(3600),
+			},
+			Expected: map[string]interface{}{
+				"violation_time_limit":         "ONE_HOUR",
+				"violation_time_limit_seconds": float64(3600),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := resourceNewRelicNrqlAlertConditionStateUpgradeV0(context.Background(), tc.RawState, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestResourceNewRelicNrqlAlertConditionStateUpgradeV0_Empty(t *testing.T) {
+	actual, err := resourceNewRelicNrqlAlertConditionStateUpgradeV0(context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, actual)
+}
+
+func TestResourceNewRelicNrqlAlertConditionStateUpgraders(t *testing.T) {
+	r := resourceNewRelicNrqlAlertCondition()
+	require.Equal(t, 1, r.SchemaVersion)
+	require.Len(t, r.StateUpgraders, 1)
+	require.Equal(t, 0, r.StateUpgraders[0].Version)
+	require.NoError(t, r.InternalValidate(nil, true))
+
+	// Version 0 of the schema decodes every attribute of the current schema
+	v0 := resourceNewRelicNrqlAlertConditionV0().Schema
+	for k := range r.Schema {
+		require.Contains(t, v0, k)
+	}
+}
@@ -565,6 +565,113 @@ func TestAccNewRelicNrqlAlertCondition_StaticConditionEvaluationDelay(t *testing
 	})
 }
 
+func TestAccNewRelicNrqlAlertCondition_StaticConditionPrediction(t *testing.T) {
+	resourceName := "newrelic_nrql_alert_condition.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicNrqlAlertConditionDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicNrqlAlertConditionStaticWithPrediction(rName, 3600, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "critical.0.prediction.0.predict_by", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_on_expected_termination", "false"),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicNrqlAlertConditionStaticWithPrediction(rName, 7200, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "critical.0.prediction.0.predict_by", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "critical.0.prediction.0.prefer_prediction_violation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_on_expected_termination", "true"),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName, "static"),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicNrqlAlertCondition_BaselineConditionSignalSeasonality(t *testing.T) {
+	resourceName := "newrelic_nrql_alert_condition.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicNrqlAlertConditionDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicNrqlAlertConditionBaselineWithSignalSeasonality(rName, "daily"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+				),
+			},
+			// Test: Update
+			{
+				Config: testAccNewRelicNrqlAlertConditionBaselineWithSignalSeasonality(rName, "WEEKLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+				),
+			},
+			// Test: Import
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccImportStateIDFunc(resourceName, "baseline"),
+			},
+		},
+	})
+}
+
+func TestAccNewRelicNrqlAlertCondition_TitleTemplate(t *testing.T) {
+	resourceName := "newrelic_nrql_alert_condition.foo"
+	rName := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckEnvVars(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNewRelicNrqlAlertConditionDestroy,
+		Steps: []resource.TestStep{
+			// Test: Create
+			{
+				Config: testAccNewRelicNrqlAlertConditionConfigBasic(rName, "120", "120", "static", "0", `title_template = "{{conditionName}} on {{tags.host}}"`, "60", "30", "259200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title_template", "{{conditionName}} on {{tags.host}}"),
+				),
+			},
+			// Test: Remove the title template
+			{
+				Config: testAccNewRelicNrqlAlertConditionConfigBasic(rName, "120", "120", "static", "0", "", "60", "30", "259200"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNewRelicNrqlAlertConditionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "title_template", ""),
+				),
+			},
+			// Test: Invalid template
+			{
+				Config:      testAccNewRelicNrqlAlertConditionConfigBasic(rName, "120", "120", "static", "0", `title_template = "{{#if tags.host}}{{tags.host}}"`, "60", "30", "259200"),
+				ExpectError: regexp.MustCompile("block `if` is never closed"),
+			},
+		},
+	})
+}
+
 func testAccCheckNewRelicNrqlAlertConditionDestroy(s *terraform.State) error {
 	providerConfig := testAccProvider.Meta().(*ProviderConfig)
 	client := providerConfig.NewClient
@@ -1137,3 +1244,81 @@ resource "newrelic_nrql_alert_condition" "foo" {
 }
 `, name)
 }
+
+func testAccNewRelicNrqlAlertConditionStaticWithPrediction(
+	name string,
+	predictBy int,
+	preferPredictionViolation bool,
+) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+	name = "tf-test-%[1]s"
+}
+
+resource "newrelic_nrql_alert_condition" "foo" {
+	policy_id   = newrelic_alert_policy.foo.id
+
+	name                           = "tf-test-%[1]s"
+	type                           = "static"
+	enabled                        = false
+	violation_time_limit_seconds   = 3600
+	close_violations_on_expiration = true
+	open_violation_on_expiration   = true
+	expiration_duration            = 120
+	ignore_on_expected_termination = %[3]t
+	aggregation_delay              = 120
+	aggregation_method             = "event_flow"
+
+	nrql {
+		query = "SELECT uniqueCount(hostname) FROM ComputeSample"
+	}
+
+	critical {
+		operator              = "above"
+		threshold             = 10
+		threshold_duration    = 120
+		threshold_occurrences = "ALL"
+
+		prediction {
+			predict_by                  = %[2]d
+			prefer_prediction_violation = %[3]t
+		}
+	}
+}
+`, name, predictBy, preferPredictionViolation)
+}
+
+func testAccNewRelicNrqlAlertConditionBaselineWithSignalSeasonality(
+	name string,
+	signalSeasonality string,
+) string {
+	return fmt.Sprintf(`
+resource "newrelic_alert_policy" "foo" {
+	name = "tf-test-%[1]s"
+}
+
+resource "newrelic_nrql_alert_condition" "foo" {
+	policy_id   = newrelic_alert_policy.foo.id
+
+	name                         = "tf-test-%[1]s"
+	type                         = "baseline"
+	enabled                      = false
+	violation_time_limit_seconds = 3600
+	aggregation_delay            = 120
+	aggregation_method           = "event_flow"
+	baseline_direction           = "upper_only"
+	signal_seasonality           = "%[2]s"
+
+	nrql {
+		query = "SELECT uniqueCount(hostname) FROM ComputeSample"
+	}
+
+	critical {
+		operator              = "above"
+		threshold             = 3
+		threshold_duration    = 120
+		threshold_occurrences = "ALL"
+	}
+}
+`, name, signalSeasonality)
+}
@@ -2,7 +2,9 @@ package newrelic
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +22,7 @@ func resourceNewRelicOneDashboard() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffDashboardLayout,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"name": {
@@ -63,6 +66,12 @@ func resourceNewRelicOneDashboard() *schema.Resource {
 				Computed:    true,
 				Description: "The URL of the dashboard.",
 			},
+			"widget_placements": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The row and column of the widgets placed by auto_layout, by page index, widget type and title.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"variable": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -176,6 +185,12 @@ func dashboardPageSchemaElem() *schema.Resource {
 				Computed:    true,
 				Description: "The unique entity identifier of the dashboard page in New Relic.",
 			},
+			"auto_layout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Place the widgets without a row and column automatically, in declaration order.",
+			},
 
 			// All the widget types below
 			"widget_area": {
@@ -202,6 +217,12 @@ func dashboardPageSchemaElem() *schema.Resource {
 				Description: "A bullet widget.",
 				Elem:        dashboardWidgetBulletSchemaElem(),
 			},
+			"widget_event_feed": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "An event feed widget.",
+				Elem:        dashboardWidgetEventFeedSchemaElem(),
+			},
 			"widget_funnel": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -238,6 +259,12 @@ func dashboardPageSchemaElem() *schema.Resource {
 				Description: "A pie widget.",
 				Elem:        dashboardWidgetPieSchemaElem(),
 			},
+			"widget_scatter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A scatter widget.",
+				Elem:        dashboardWidgetScatterSchemaElem(),
+			},
 			"widget_log_table": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -279,8 +306,9 @@ func dashboardWidgetSchemaBase() map[string]*schema.Schema {
 			Description: "A title for the widget.",
 		},
 		"column": {
-			Type:     schema.TypeInt,
-			Required: true,
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The column of the widget. Required unless auto_layout is enabled on the page.",
 		},
 		"height": {
 			Type:         schema.TypeInt,
@@ -289,8 +317,9 @@ func dashboardWidgetSchemaBase() map[string]*schema.Schema {
 			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"row": {
-			Type:     schema.TypeInt,
-			Required: true,
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The row of the widget. Required unless auto_layout is enabled on the page.",
 		},
 		"width": {
 			Type:         schema.TypeInt,
@@ -450,9 +479,92 @@ func dashboardWidgetNRQLQuerySchemaElem() *schema.Resource {
 	}
 }
 
+func dashboardWidgetThresholdSchemaElem(seriesKey string, seriesDescription string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFloatString,
+				Description:  "The value the threshold zone starts from.",
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFloatString,
+				Description:  "The value the threshold zone ends at.",
+			},
+			seriesKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: seriesDescription,
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dashboardWidgetThresholdSeverities, false),
+				Description:  fmt.Sprintf("The severity of the threshold zone. One of: (%s).", strings.Join(dashboardWidgetThresholdSeverities, ", ")),
+			},
+		},
+	}
+}
+
+func dashboardWidgetYAxisRightSchemaElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zero": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies if the right Y axis starts at zero.",
+			},
+			"min": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFloatString,
+				Description:  "The minimum value of the right Y axis.",
+			},
+			"max": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateFloatString,
+				Description:  "The maximum value of the right Y axis.",
+			},
+			"series": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The names of the series plotted against the right Y axis.",
+			},
+		},
+	}
+}
+
+// dashboardWidgetChartSchema adds the thresholds and tooltip options shared by line and area charts.
+func dashboardWidgetChartSchema(s map[string]*schema.Schema) {
+	s["threshold"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        dashboardWidgetThresholdSchemaElem("name", "The name of the threshold zone."),
+		Description: "The threshold zones of the chart.",
+	}
+	s["is_label_visible"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Specifies if the names of the threshold zones are shown on the chart.",
+	}
+	s["tooltip_mode"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(dashboardWidgetTooltipModes, false),
+		Description:  fmt.Sprintf("The series shown in the tooltip of the chart. One of: (%s).", strings.Join(dashboardWidgetTooltipModes, ", ")),
+	}
+}
+
 func dashboardWidgetAreaSchemaElem() *schema.Resource {
 	s := dashboardWidgetSchemaBase()
 
+	dashboardWidgetChartSchema(s)
+
 	return &schema.Resource{
 		Schema: s,
 	}
@@ -503,6 +615,19 @@ func dashboardWidgetBulletSchemaElem() *schema.Resource {
 	}
 }
 
+func dashboardWidgetEventFeedSchemaElem() *schema.Resource {
+	s := dashboardWidgetSchemaBase()
+
+	// Event feeds list events, they have no axis, series or legend
+	for _, k := range dashboardWidgetEventFeedIgnoredAttributes {
+		delete(s, k)
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
 func dashboardWidgetFunnelSchemaElem() *schema.Resource {
 	s := dashboardWidgetSchemaBase()
 
@@ -538,6 +663,15 @@ func dashboardWidgetLineSchemaElem() *schema.Resource {
 		Optional:    true,
 		Description: "Specifies if the values on the graph to be rendered need to be fit to scale, or printed within the specified range.",
 	}
+	s["y_axis_right"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem:        dashboardWidgetYAxisRightSchemaElem(),
+		Description: "A right Y axis, plotting the listed series on their own scale.",
+	}
+	dashboardWidgetChartSchema(s)
+
 	return &schema.Resource{
 		Schema: s,
 	}
@@ -586,6 +720,31 @@ func dashboardWidgetPieSchemaElem() *schema.Resource {
 	}
 }
 
+func dashboardWidgetScatterSchemaElem() *schema.Resource {
+	s := dashboardWidgetSchemaBase()
+
+	// Scatter charts plot points, there are no lines to fill the gaps of
+	delete(s, "null_values")
+
+	s["linked_entity_guids"] = dashboardWidgetLinkedEntityGUIDsSchema()
+	s["filter_current_dashboard"] = dashboardWidgetFilterCurrentDashboardSchema()
+	s["y_axis_left_zero"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Specifies if the Y axis starts at zero, rather than at the lowest value plotted.",
+	}
+	s["tooltip_mode"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(dashboardWidgetTooltipModes, false),
+		Description:  fmt.Sprintf("The points shown in the tooltip of the chart. One of: (%s).", strings.Join(dashboardWidgetTooltipModes, ", ")),
+	}
+
+	return &schema.Resource{
+		Schema: s,
+	}
+}
+
 func dashboardWidgetLogTableSchemaElem() *schema.Resource {
 	s := dashboardWidgetSchemaBase()
 
@@ -599,6 +758,12 @@ func dashboardWidgetTableSchemaElem() *schema.Resource {
 
 	s["linked_entity_guids"] = dashboardWidgetLinkedEntityGUIDsSchema()
 	s["filter_current_dashboard"] = dashboardWidgetFilterCurrentDashboardSchema()
+	s["threshold"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        dashboardWidgetThresholdSchemaElem("column_name", "The name of the column the threshold applies to."),
+		Description: "The thresholds highlighting the cells of the table.",
+	}
 
 	return &schema.Resource{
 		Schema: s,
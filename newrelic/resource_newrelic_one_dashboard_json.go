@@ -22,12 +22,19 @@ func resourceNewRelicOneDashboardJSON() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffDashboardJSONChangedPaths,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"json": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The dashboard's json.",
+				// Store the normalized document so plans only show the parts of the dashboard that changed
+				StateFunc: func(v interface{}) string {
+					return canonicalDashboardJSON(v.(string))
+				},
+				DiffSuppressFunc: diffSuppressDashboardJSON,
+				ValidateFunc:     validateDashboardJSONDocument,
 			},
 			// Optional
 			"account_id": {
@@ -52,6 +59,12 @@ func resourceNewRelicOneDashboardJSON() *schema.Resource {
 				Computed:    true,
 				Description: "The URL of the dashboard.",
 			},
+			"changed_paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The paths of the dashboard's json changed by the last update.",
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
@@ -777,6 +777,24 @@ func testAccCheckNewRelicOneDashboardConfig_PageFull(pageName string, accountID
 		  query      = "FROM Transaction SELECT average(duration) FACET appName TIMESERIES"
 		}
 	}
+
+	widget_event_feed {
+		title = "event feed widget"
+		row = 14
+		column = 5
+		nrql_query {
+		  query      = "FROM Transaction SELECT appName, duration"
+		}
+	}
+
+	widget_scatter {
+		title = "scatter widget"
+		row = 14
+		column = 9
+		nrql_query {
+		  query      = "FROM Transaction SELECT duration, databaseDuration"
+		}
+	}
   }
 `
 }
@@ -949,6 +967,18 @@ func testAccCheckNewRelicOneDashboardConfig_PageFullChanged(pageName string, acc
       }
       y_axis_left_zero = true
       y_axis_left_max = 25
+      is_label_visible = true
+      tooltip_mode = "single"
+      threshold {
+        from = 1
+        to = 2
+        name = "Slow"
+        severity = "warning"
+      }
+      y_axis_right {
+        zero = true
+        series = ["Count"]
+      }
     }
 
     widget_markdown {
@@ -1004,6 +1034,26 @@ func testAccCheckNewRelicOneDashboardConfig_PageFullChanged(pageName string, acc
 		  query      = "FROM Transaction SELECT average(duration) FACET appName TIMESERIES LIMIT 10"
 		}
 	}
+
+	widget_event_feed {
+		title = "event feed widget"
+		row = 14
+		column = 5
+		nrql_query {
+		  query      = "FROM Transaction SELECT appName, duration LIMIT 10"
+		}
+	}
+
+	widget_scatter {
+		title = "scatter widget"
+		row = 14
+		column = 9
+		y_axis_left_zero = true
+		tooltip_mode = "single"
+		nrql_query {
+		  query      = "FROM Transaction SELECT duration, databaseDuration LIMIT 10"
+		}
+	}
   }
 `
 }
@@ -22,7 +22,7 @@ func resourceNewRelicServiceLevel() *schema.Resource {
 		UpdateContext: resourceNewRelicServiceLevelUpdate,
 		DeleteContext: resourceNewRelicServiceLevelDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithEntityGUID("EXT", "SERVICE_LEVEL", resourceNewRelicServiceLevelImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			"guid": {
@@ -260,6 +260,30 @@ func resourceNewRelicServiceLevelCreate(ctx context.Context, d *schema.ResourceD
 	return diag.FromErr(flattenServiceLevelIndicator(*created, &identifier, d, sliGUID))
 }
 
+func resourceNewRelicServiceLevelImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+
+	indicators, err := client.ServiceLevel.GetIndicatorsWithContext(ctx, common.EntityGUID(guid.GUID))
+	if err != nil {
+		return err
+	}
+
+	for _, indicator := range *indicators {
+		if indicator.ID == guid.Identifier {
+			identifier := serviceLevelIdentifier{
+				AccountID:  guid.AccountID,
+				ID:         indicator.ID,
+				EntityGUID: string(indicator.EntityGUID),
+			}
+			d.SetId(identifier.String())
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no service level found with entity GUID `%s`", guid.GUID)
+}
+
 func resourceNewRelicServiceLevelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
 
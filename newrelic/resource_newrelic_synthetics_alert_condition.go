@@ -20,7 +20,7 @@ func resourceNewRelicSyntheticsAlertCondition() *schema.Resource {
 		UpdateContext:      resourceNewRelicSyntheticsAlertConditionUpdate,
 		DeleteContext:      resourceNewRelicSyntheticsAlertConditionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithEntityGUID("AIOPS", "CONDITION", resourceNewRelicSyntheticsAlertConditionImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
@@ -116,6 +116,37 @@ func resourceNewRelicSyntheticsAlertConditionCreate(ctx context.Context, d *sche
 	return resourceNewRelicSyntheticsAlertConditionRead(ctx, d, meta)
 }
 
+func resourceNewRelicSyntheticsAlertConditionImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+
+	conditionID, err := strconv.Atoi(guid.Identifier)
+	if err != nil {
+		return err
+	}
+
+	policyID, err := findAlertConditionPolicyID(ctx, meta, guid, func(policyID int) (bool, error) {
+		conditions, err := client.Alerts.ListSyntheticsConditionsWithContext(ctx, policyID)
+		if err != nil {
+			return false, err
+		}
+
+		for _, condition := range conditions {
+			if condition.ID == conditionID {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serializeIDs([]int{policyID, conditionID}))
+
+	return nil
+}
+
 func resourceNewRelicSyntheticsAlertConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
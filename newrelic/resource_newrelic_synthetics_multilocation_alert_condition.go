@@ -31,7 +31,7 @@ func resourceNewRelicSyntheticsMultiLocationAlertCondition() *schema.Resource {
 		UpdateContext: resourceNewRelicSyntheticsMultiLocationAlertConditionUpdate,
 		DeleteContext: resourceNewRelicSyntheticsMultiLocationAlertConditionDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithEntityGUID("AIOPS", "CONDITION", resourceNewRelicSyntheticsMultiLocationAlertConditionImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -124,6 +124,37 @@ func resourceNewRelicSyntheticsMultiLocationAlertConditionCreate(ctx context.Con
 	return resourceNewRelicSyntheticsMultiLocationAlertConditionRead(ctx, d, meta)
 }
 
+func resourceNewRelicSyntheticsMultiLocationAlertConditionImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+
+	conditionID, err := strconv.Atoi(guid.Identifier)
+	if err != nil {
+		return err
+	}
+
+	policyID, err := findAlertConditionPolicyID(ctx, meta, guid, func(policyID int) (bool, error) {
+		conditions, err := client.Alerts.ListMultiLocationSyntheticsConditionsWithContext(ctx, policyID)
+		if err != nil {
+			return false, err
+		}
+
+		for _, condition := range conditions {
+			if condition.ID == conditionID {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serializeIDs([]int{policyID, conditionID}))
+
+	return nil
+}
+
 func resourceNewRelicSyntheticsMultiLocationAlertConditionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConfig := meta.(*ProviderConfig)
 	client := providerConfig.NewClient
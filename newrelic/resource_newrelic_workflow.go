@@ -22,7 +22,7 @@ func resourceNewRelicWorkflow() *schema.Resource {
 		UpdateContext: resourceNewRelicWorkflowUpdate,
 		DeleteContext: resourceNewRelicWorkflowDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceImportStateWithEntityGUID("AIOPS", "WORKFLOW", resourceNewRelicWorkflowImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			// Required
@@ -93,27 +93,7 @@ func resourceNewRelicWorkflow() *schema.Resource {
 							Type:        schema.TypeList,
 							Optional:    true,
 							Description: "",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"attribute": {
-										Type:        schema.TypeString,
-										Required:    true,
-										Description: "(Required) predicate's attribute.",
-									},
-									"operator": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice(listValidWorkflowsOperatorTypes(), false),
-										Description:  fmt.Sprintf("The type of the operator. One of: (%s).", strings.Join(listValidWorkflowsOperatorTypes(), ", ")),
-									},
-									"values": {
-										Type:        schema.TypeList,
-										Required:    true,
-										Description: "List of predicate values.",
-										Elem:        &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
+							Elem:        workflowIssuesFilterPredicateSchema(),
 						},
 
 						// Computed
@@ -479,6 +459,34 @@ func resourceNewRelicWorkflowCreate(ctx context.Context, d *schema.ResourceData,
 	return resourceNewRelicWorkflowRead(updatedContext, d, meta)
 }
 
+// Resolves the ID of the workflow identified by an entity GUID. Workflows can't be
+// filtered by GUID, so the workflows of the GUID's account are searched instead.
+func resourceNewRelicWorkflowImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	client := meta.(*ProviderConfig).NewClient
+	updatedContext := updateContextWithAccountID(ctx, guid.AccountID)
+
+	cursor := ""
+	for {
+		workflowResponse, err := client.Workflows.GetWorkflowsWithContext(updatedContext, guid.AccountID, cursor, ai.AiWorkflowsFilters{})
+		if err != nil {
+			return err
+		}
+
+		for _, workflow := range workflowResponse.Entities {
+			if strings.TrimRight(string(workflow.GUID), "=") == strings.TrimRight(guid.GUID, "=") {
+				d.SetId(workflow.ID)
+				return d.Set("account_id", guid.AccountID)
+			}
+		}
+
+		if workflowResponse.NextCursor == "" {
+			return fmt.Errorf("no workflow found with entity GUID `%s` in account %d", guid.GUID, guid.AccountID)
+		}
+
+		cursor = workflowResponse.NextCursor
+	}
+}
+
 func resourceNewRelicWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).NewClient
 
@@ -592,6 +600,30 @@ func listValidWorkflowsFilterTypes() []string {
 	}
 }
 
+func workflowIssuesFilterPredicateSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"attribute": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "(Required) predicate's attribute.",
+			},
+			"operator": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(listValidWorkflowsOperatorTypes(), false),
+				Description:  fmt.Sprintf("The type of the operator. One of: (%s).", strings.Join(listValidWorkflowsOperatorTypes(), ", ")),
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of predicate values.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
 // Validation function to validate allowed predicate operator types
 func listValidWorkflowsOperatorTypes() []string {
 	return []string{
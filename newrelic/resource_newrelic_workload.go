@@ -21,7 +21,7 @@ func resourceNewRelicWorkload() *schema.Resource {
 		UpdateContext: resourceNewRelicWorkloadUpdate,
 		DeleteContext: resourceNewRelicWorkloadDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceImportStateWithEntityGUID("NR1", "WORKLOAD", resourceNewRelicWorkloadImportGUID, nil),
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -315,6 +315,24 @@ func resourceNewRelicWorkloadDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// Builds the composite workload ID out of the workload's entity GUID.
+func resourceNewRelicWorkloadImportGUID(ctx context.Context, d *schema.ResourceData, meta interface{}, guid *entityGUID) error {
+	workloadID, err := strconv.Atoi(guid.Identifier)
+	if err != nil {
+		return err
+	}
+
+	ids := workloadIDs{
+		AccountID: guid.AccountID,
+		ID:        workloadID,
+		GUID:      common.EntityGUID(guid.GUID),
+	}
+
+	d.SetId(ids.String())
+
+	return nil
+}
+
 func parseWorkloadIDs(ids string) (*workloadIDs, error) {
 	split := strings.Split(ids, ":")
 
@@ -3,6 +3,7 @@ package newrelic
 import (
 	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -22,8 +23,13 @@ func expandMutingRuleCreateInput(d *schema.ResourceData) (alerts.MutingRuleCreat
 		createInput.Condition = expandMutingRuleConditionGroup(e.([]interface{})[0].(map[string]interface{}))
 	}
 
-	if e, ok := d.GetOk("schedule"); ok {
-		schedule, err := expandMutingRuleCreateSchedule(e.([]interface{})[0].(map[string]interface{}))
+	cfg, err := mutingRuleScheduleConfig(d.Get("schedule").([]interface{}), d.Get("ical_event").(string), d.Get("rrule").(string))
+	if err != nil {
+		return alerts.MutingRuleCreateInput{}, err
+	}
+
+	if cfg != nil {
+		schedule, err := expandMutingRuleCreateSchedule(cfg)
 		if err != nil {
 			return alerts.MutingRuleCreateInput{}, err
 		}
@@ -205,8 +211,13 @@ func expandMutingRuleUpdateInput(d *schema.ResourceData) (alerts.MutingRuleUpdat
 		updateInput.Condition = &x
 	}
 
-	if e, ok := d.GetOk("schedule"); ok {
-		schedule, err := expandMutingRuleUpdateSchedule(e.([]interface{})[0].(map[string]interface{}))
+	cfg, err := mutingRuleScheduleConfig(d.Get("schedule").([]interface{}), d.Get("ical_event").(string), d.Get("rrule").(string))
+	if err != nil {
+		return alerts.MutingRuleUpdateInput{}, err
+	}
+
+	if cfg != nil {
+		schedule, err := expandMutingRuleUpdateSchedule(cfg)
 		if err != nil {
 			return alerts.MutingRuleUpdateInput{}, err
 		}
@@ -279,12 +290,23 @@ func flattenMutingRule(mutingRule *alerts.MutingRule, d *schema.ResourceData) er
 	_ = d.Set("name", mutingRule.Name)
 
 	if mutingRule.Schedule != nil {
-		if err := d.Set("schedule", flattenSchedule(mutingRule.Schedule)); err != nil {
+		schedule, err := flattenMutingRuleScheduleWithRecurrence(mutingRule.Schedule, d)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("schedule", schedule); err != nil {
 			return fmt.Errorf("[Error] Error setting `schedule`: %v", err)
 		}
 	}
 
-	return nil
+	occurrences, err := expandMutingRuleScheduleNextOccurrences(d.Get("schedule").([]interface{}), d.Get("ical_event").(string), d.Get("rrule").(string))
+	if err != nil {
+		log.Printf("[WARN] Unable to compute the next occurrences of muting rule schedule: %v", err)
+		occurrences = []string{}
+	}
+
+	return d.Set("next_occurrences", occurrences)
 }
 
 func flattenMutingRuleConditionGroup(in alerts.MutingRuleConditionGroup, configuredCondition []interface{}) []map[string]interface{} {
@@ -341,6 +363,44 @@ func flattenMutingRuleCondition(conditions []interface{}) []map[string]interface
 	return condition
 }
 
+// Flattens the schedule of a muting rule defined by an `ical_event` or an `rrule`. The
+// arguments of the schedule block derived from them are left out while the schedule matches
+// them, so that only drift shows up in plans.
+func flattenMutingRuleScheduleWithRecurrence(schedule *alerts.MutingRuleSchedule, d *schema.ResourceData) ([]interface{}, error) {
+	flattened := flattenSchedule(schedule)
+
+	icalEvent := d.Get("ical_event").(string)
+	rrule := d.Get("rrule").(string)
+	if icalEvent == "" && rrule == "" {
+		return flattened, nil
+	}
+
+	expected, err := mutingRuleScheduleConfig(d.Get("schedule").([]interface{}), icalEvent, rrule)
+	if err != nil {
+		return nil, fmt.Errorf("[Error] Error parsing the recurrence of `schedule`: %v", err)
+	}
+
+	out := flattened[0].(map[string]interface{})
+	derived := []string{"repeat", "end_repeat", "repeat_count", "weekly_repeat_days"}
+	if icalEvent != "" {
+		derived = mutingRuleScheduleAttributes
+	}
+
+	if !mutingRuleScheduleConfigsEqual(out, expected, derived) {
+		return flattened, nil
+	}
+
+	if icalEvent != "" {
+		return []interface{}{}, nil
+	}
+
+	for _, attribute := range derived {
+		delete(out, attribute)
+	}
+
+	return flattened, nil
+}
+
 func flattenSchedule(schedule *alerts.MutingRuleSchedule) []interface{} {
 	out := map[string]interface{}{}
 
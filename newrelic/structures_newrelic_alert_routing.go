@@ -0,0 +1,274 @@
+package newrelic
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
+)
+
+// The issue attribute matched against the policy IDs of an alert routing.
+const alertRoutingPolicyIDsAttribute = "labels.policyIds"
+
+// An object managed by an alert routing, along with the resource data used to manage it
+// through the functions of its own resource.
+type alertRoutingChild struct {
+	Kind     string
+	Resource *schema.Resource
+	Data     *schema.ResourceData
+}
+
+// The destination, channel and workflow managed by an alert routing.
+type alertRoutingChildren struct {
+	Destination *alertRoutingChild
+	Channel     *alertRoutingChild
+	Workflow    *alertRoutingChild
+}
+
+// Returns the objects of an alert routing in the order they are created.
+func (c *alertRoutingChildren) list() []*alertRoutingChild {
+	return []*alertRoutingChild{c.Destination, c.Channel, c.Workflow}
+}
+
+// Builds the resource data of the destination, channel and workflow of an alert routing out
+// of its attributes, as returned by get, so that their own resources can manage them.
+func newAlertRoutingChildren(get func(key string) interface{}, accountID int, destinationID string, channelID string) (*alertRoutingChildren, error) {
+	name := get("name").(string)
+
+	destination := map[string]interface{}{
+		"account_id": accountID,
+		"name":       name,
+		"active":     true,
+		"property":   []interface{}{},
+	}
+
+	destinationType := ""
+	if cfg := get("destination").([]interface{}); len(cfg) > 0 && cfg[0] != nil {
+		block := cfg[0].(map[string]interface{})
+		destinationType = block["type"].(string)
+
+		destination["type"] = destinationType
+		destination["property"] = block["property"].(*schema.Set).List()
+		destination["auth_basic"] = block["auth_basic"]
+		destination["auth_token"] = block["auth_token"]
+	}
+
+	channel := map[string]interface{}{
+		"account_id":     accountID,
+		"name":           name,
+		"active":         true,
+		"destination_id": destinationID,
+		"type":           string(alertRoutingChannelTypes[notifications.AiNotificationsDestinationType(destinationType)]),
+		"product":        string(notifications.AiNotificationsProductTypes.IINT),
+		"property":       []interface{}{},
+	}
+
+	if cfg := get("channel").([]interface{}); len(cfg) > 0 && cfg[0] != nil {
+		block := cfg[0].(map[string]interface{})
+
+		if t := block["type"].(string); t != "" {
+			channel["type"] = t
+		}
+		if p := block["product"].(string); p != "" {
+			channel["product"] = p
+		}
+		channel["property"] = block["property"].(*schema.Set).List()
+	}
+
+	policyIDs := get("policy_ids").(*schema.Set).List()
+
+	workflow := map[string]interface{}{
+		"account_id":            accountID,
+		"name":                  name,
+		"enabled":               get("enabled"),
+		"enrichments_enabled":   true,
+		"destinations_enabled":  true,
+		"muting_rules_handling": get("muting_rules_handling"),
+		"destination": []interface{}{
+			map[string]interface{}{
+				"channel_id":            channelID,
+				"notification_triggers": get("notification_triggers"),
+			},
+		},
+		"issues_filter": []interface{}{
+			map[string]interface{}{
+				"name": name,
+				"type": string(workflows.AiWorkflowsFilterTypeTypes.FILTER),
+				"predicate": []interface{}{
+					map[string]interface{}{
+						"attribute": alertRoutingPolicyIDsAttribute,
+						"operator":  string(workflows.AiWorkflowsOperatorTypes.EXACTLY_MATCHES),
+						"values":    policyIDs,
+					},
+				},
+			},
+		},
+		"enrichments": get("enrichments").(*schema.Set).List(),
+	}
+
+	children := &alertRoutingChildren{
+		Destination: &alertRoutingChild{Kind: "notification destination", Resource: resourceNewRelicNotificationDestination()},
+		Channel:     &alertRoutingChild{Kind: "notification channel", Resource: resourceNewRelicNotificationChannel()},
+		Workflow:    &alertRoutingChild{Kind: "workflow", Resource: resourceNewRelicWorkflow()},
+	}
+
+	var err error
+	if children.Destination.Data, err = resourceDataFromAttributes(children.Destination.Resource, destination); err != nil {
+		return nil, fmt.Errorf("error building notification destination: %v", err)
+	}
+	children.Destination.Data.SetId(destinationID)
+
+	if children.Channel.Data, err = resourceDataFromAttributes(children.Channel.Resource, channel); err != nil {
+		return nil, fmt.Errorf("error building notification channel: %v", err)
+	}
+	children.Channel.Data.SetId(channelID)
+
+	if children.Workflow.Data, err = resourceDataFromAttributes(children.Workflow.Resource, workflow); err != nil {
+		return nil, fmt.Errorf("error building workflow: %v", err)
+	}
+
+	return children, nil
+}
+
+// Sets the channel of the single destination configuration of the workflow of an alert routing.
+func setAlertRoutingWorkflowChannel(workflow *schema.ResourceData, channelID string) error {
+	configurations := workflow.Get("destination").(*schema.Set).List()
+
+	configuration := map[string]interface{}{}
+	for k, v := range configurations[0].(map[string]interface{}) {
+		configuration[k] = v
+	}
+	configuration["channel_id"] = channelID
+
+	return workflow.Set("destination", []interface{}{configuration})
+}
+
+// Returns the channel of the single destination configuration of the workflow of an alert routing.
+func alertRoutingWorkflowChannelID(workflow *schema.ResourceData) string {
+	for _, c := range workflow.Get("destination").(*schema.Set).List() {
+		return c.(map[string]interface{})["channel_id"].(string)
+	}
+
+	return ""
+}
+
+// Sets the ID of the issues filter and of the workflow of an alert routing, as read in current,
+// which are required to update the workflow.
+func setAlertRoutingWorkflowFilterID(workflow *schema.ResourceData, current *schema.ResourceData) error {
+	filterID := ""
+	for _, f := range current.Get("issues_filter").(*schema.Set).List() {
+		filterID = f.(map[string]interface{})["filter_id"].(string)
+	}
+
+	filters := workflow.Get("issues_filter").(*schema.Set).List()
+	filter := map[string]interface{}{}
+	for k, v := range filters[0].(map[string]interface{}) {
+		filter[k] = v
+	}
+	filter["filter_id"] = filterID
+
+	if err := workflow.Set("issues_filter", []interface{}{filter}); err != nil {
+		return err
+	}
+
+	return workflow.Set("workflow_id", current.Get("workflow_id"))
+}
+
+func flattenAlertRouting(children *alertRoutingChildren, d *schema.ResourceData) error {
+	destination := children.Destination.Data
+	channel := children.Channel.Data
+	workflow := children.Workflow.Data
+
+	if err := d.Set("account_id", workflow.Get("account_id")); err != nil {
+		return err
+	}
+
+	if err := d.Set("name", workflow.Get("name")); err != nil {
+		return err
+	}
+
+	if err := d.Set("destination_id", destination.Id()); err != nil {
+		return err
+	}
+
+	if err := d.Set("channel_id", channel.Id()); err != nil {
+		return err
+	}
+
+	// Imported alert routings have no destination yet, all their properties are kept
+	importing := len(d.Get("destination").([]interface{})) == 0
+
+	if err := d.Set("destination", []interface{}{
+		map[string]interface{}{
+			"type":       destination.Get("type"),
+			"property":   alertRoutingProperties(destination.Get("property").(*schema.Set).List(), d.Get("destination.0.property").(*schema.Set).List(), importing),
+			"auth_basic": destination.Get("auth_basic"),
+			"auth_token": destination.Get("auth_token"),
+		},
+	}); err != nil {
+		return err
+	}
+
+	if err := d.Set("channel", []interface{}{
+		map[string]interface{}{
+			"type":     channel.Get("type"),
+			"product":  channel.Get("product"),
+			"property": alertRoutingProperties(channel.Get("property").(*schema.Set).List(), d.Get("channel.0.property").(*schema.Set).List(), importing),
+		},
+	}); err != nil {
+		return err
+	}
+
+	policyIDs := []interface{}{}
+	for _, f := range workflow.Get("issues_filter").(*schema.Set).List() {
+		for _, p := range f.(map[string]interface{})["predicate"].([]interface{}) {
+			predicate := p.(map[string]interface{})
+			if predicate["attribute"] == alertRoutingPolicyIDsAttribute {
+				policyIDs = append(policyIDs, predicate["values"].([]interface{})...)
+			}
+		}
+	}
+
+	if err := d.Set("policy_ids", policyIDs); err != nil {
+		return err
+	}
+
+	for _, c := range workflow.Get("destination").(*schema.Set).List() {
+		if err := d.Set("notification_triggers", c.(map[string]interface{})["notification_triggers"]); err != nil {
+			return err
+		}
+	}
+
+	if err := d.Set("muting_rules_handling", workflow.Get("muting_rules_handling")); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", workflow.Get("enabled")); err != nil {
+		return err
+	}
+
+	return d.Set("enrichments", workflow.Get("enrichments").(*schema.Set).List())
+}
+
+// Returns the properties of a destination or channel whose key is set on the alert routing, the
+// properties populated by the API are left out so they don't show as removed in every plan.
+func alertRoutingProperties(properties []interface{}, configured []interface{}, importing bool) []interface{} {
+	if importing {
+		return properties
+	}
+
+	keys := map[string]bool{}
+	for _, p := range configured {
+		keys[p.(map[string]interface{})["key"].(string)] = true
+	}
+
+	out := []interface{}{}
+	for _, p := range properties {
+		if keys[p.(map[string]interface{})["key"].(string)] {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,210 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/common"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+)
+
+// The rawConfiguration properties of widgets and the newrelic_one_dashboard widget attributes
+// they are read into. A property is converted when the widget block has one of its attributes.
+var dashboardConvertRawConfigurationAttributes = map[string][]string{
+	"colors":          {"colors"},
+	"facet":           {"facet_show_other_series"},
+	"legend":          {"legend_enabled"},
+	"limit":           {"limit"},
+	"nrqlQueries":     {"nrql_query"},
+	"nullValues":      {"null_values"},
+	"platformOptions": {"ignore_time_range"},
+	"text":            {"text"},
+	"thresholds":      {"threshold", "critical", "warning"},
+	"tooltip":         {"tooltip_mode"},
+	"units":           {"units"},
+	"yAxisLeft":       {"y_axis_left_min", "y_axis_left_max", "y_axis_left_zero"},
+	"yAxisRight":      {"y_axis_right"},
+}
+
+// convertDashboardRawWidget converts a widget of newrelic_one_dashboard_raw, as flattened by
+// flattenDashboardRawWidget, to a widget block of newrelic_one_dashboard. It returns the type of
+// the widget block, empty when the visualization has none, and the parts of the configuration
+// the widget block can't hold.
+func convertDashboardRawWidget(raw map[string]interface{}, pageGUID string) (string, map[string]interface{}, []string) {
+	widget := entities.DashboardWidget{
+		Visualization: entities.DashboardWidgetVisualization{ID: raw["visualization_id"].(string)},
+	}
+
+	if v, ok := raw["id"].(string); ok {
+		widget.ID = v
+	}
+	if v, ok := raw["title"].(string); ok {
+		widget.Title = v
+	}
+	widget.Layout.Row, _ = raw["row"].(int)
+	widget.Layout.Column, _ = raw["column"].(int)
+	widget.Layout.Width, _ = raw["width"].(int)
+	widget.Layout.Height, _ = raw["height"].(int)
+
+	if guids, ok := raw["linked_entity_guids"].([]string); ok {
+		for _, guid := range guids {
+			widget.LinkedEntities = append(widget.LinkedEntities, &entities.GenericEntityOutline{GUID: common.EntityGUID(guid)})
+		}
+	}
+
+	if v, ok := raw["configuration"].(string); ok {
+		widget.RawConfiguration = []byte(v)
+	}
+
+	widgetType, out := flattenDashboardWidget(&widget, pageGUID)
+	if widgetType == "" {
+		return "", nil, nil
+	}
+
+	// filter_current_dashboard links the widget to its page once converted
+	if filter, _ := out["filter_current_dashboard"].(bool); filter {
+		delete(out, "linked_entity_guids")
+	}
+
+	widgetSchema := dashboardPageSchemaElem().Schema[widgetType].Elem.(*schema.Resource).Schema
+
+	return widgetType, out, dashboardConvertLostConfiguration(widget.Visualization.ID, widget.RawConfiguration, widgetSchema)
+}
+
+// dashboardConvertLostConfiguration lists the rawConfiguration properties of a widget that have
+// no attribute in its widget block.
+func dashboardConvertLostConfiguration(visualizationID string, rawConfiguration []byte, widgetSchema map[string]*schema.Schema) []string {
+	if len(rawConfiguration) == 0 {
+		return nil
+	}
+
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(rawConfiguration, &cfg); err != nil {
+		return []string{"rawConfiguration"}
+	}
+
+	lost := []string{}
+	for property, value := range cfg {
+		converted := false
+		for _, attr := range dashboardConvertRawConfigurationAttributes[property] {
+			if _, ok := widgetSchema[attr]; ok {
+				converted = true
+				break
+			}
+		}
+
+		if !converted {
+			lost = append(lost, property)
+			continue
+		}
+
+		switch property {
+		case "nrqlQueries":
+			// Queries across several accounts have no equivalent in nrql_query blocks
+			queries, _ := value.([]interface{})
+			for i, q := range queries {
+				query, _ := q.(map[string]interface{})
+				for k := range query {
+					if k != "accountId" && k != "query" {
+						lost = append(lost, fmt.Sprintf("nrqlQueries[%d].%s", i, k))
+					}
+				}
+			}
+		case "thresholds":
+			if visualizationID != "viz.billboard" {
+				continue
+			}
+
+			thresholds, _ := value.([]interface{})
+			for i, t := range thresholds {
+				threshold, _ := t.(map[string]interface{})
+				if severity := threshold["alertSeverity"]; severity != "CRITICAL" && severity != "WARNING" {
+					lost = append(lost, fmt.Sprintf("thresholds[%d]", i))
+				}
+			}
+		}
+	}
+
+	sort.Strings(lost)
+
+	return lost
+}
+
+// buildDashboardConvertHCL renders a dashboard managed with newrelic_one_dashboard_raw as a
+// newrelic_one_dashboard resource. Widgets without a widget block are left out, and returned
+// along with the widgets losing part of their configuration.
+func buildDashboardConvertHCL(dashboard *entities.DashboardEntity, resourceName string) (*hclwrite.File, []string) {
+	unconverted := []string{}
+	comments := []string{}
+	rawPages := flattenDashboardRawPage(&dashboard.Pages)
+	pages := make([]interface{}, len(rawPages))
+
+	for i, p := range rawPages {
+		rawPage := p.(map[string]interface{})
+		pageName := rawPage["name"].(string)
+		page := map[string]interface{}{
+			"name": pageName,
+		}
+		if description, ok := rawPage["description"]; ok {
+			page["description"] = description
+		}
+
+		widgets, _ := rawPage["widget"].([]interface{})
+		for _, w := range widgets {
+			raw := w.(map[string]interface{})
+			title, _ := raw["title"].(string)
+
+			widgetType, out, lost := convertDashboardRawWidget(raw, string(rawPage["guid"].(common.EntityGUID)))
+			if widgetType == "" {
+				unconverted = append(unconverted, fmt.Sprintf("%s / %s (%s)", pageName, title, raw["visualization_id"]))
+				comments = append(comments, fmt.Sprintf("Widget %q of page %q uses the %s visualization, which has no widget block, it is left out.", title, pageName, raw["visualization_id"]))
+				continue
+			}
+
+			if len(lost) > 0 {
+				for _, property := range lost {
+					unconverted = append(unconverted, fmt.Sprintf("%s / %s (%s)", pageName, title, property))
+				}
+				comments = append(comments, fmt.Sprintf("Widget %q of page %q loses its %s configuration as a %s.", title, pageName, strings.Join(lost, ", "), widgetType))
+			}
+
+			if _, ok := page[widgetType]; !ok {
+				page[widgetType] = []interface{}{}
+			}
+			page[widgetType] = append(page[widgetType].([]interface{}), out)
+		}
+
+		pages[i] = page
+	}
+
+	attributes := map[string]interface{}{
+		"name":        dashboard.Name,
+		"description": dashboard.Description,
+		"permissions": strings.ToLower(string(dashboard.Permissions)),
+		"page":        pages,
+	}
+
+	if len(dashboard.Variables) > 0 {
+		attributes["variable"] = flattenDashboardVariable(&dashboard.Variables)
+	}
+
+	return buildDashboardHCL(attributes, comments, resourceName), unconverted
+}
+
+func flattenDashboardConvert(dashboard *entities.DashboardEntity, resourceName string, d *schema.ResourceData) error {
+	if resourceName == "" {
+		resourceName = dashboardExportResourceName(dashboard.Name)
+	}
+
+	file, unconverted := buildDashboardConvertHCL(dashboard, resourceName)
+
+	d.SetId(string(dashboard.GUID))
+	_ = d.Set("name", dashboard.Name)
+	_ = d.Set("hcl", string(hclwrite.Format(file.Bytes())))
+
+	return d.Set("unconverted_widgets", unconverted)
+}
@@ -0,0 +1,104 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func testDashboardConvertEntity() *entities.DashboardEntity {
+	return &entities.DashboardEntity{
+		AccountID:   1,
+		GUID:        "MXxWSVp8REFTSEJPQVJEfDE",
+		Name:        "Checkout Service",
+		Permissions: entities.DashboardPermissionsTypes.PUBLIC_READ_ONLY,
+		Pages: []entities.DashboardPage{
+			{
+				GUID: "MXxWSVp8REFTSEJPQVJEfDI",
+				Name: "Overview",
+				Widgets: []entities.DashboardWidget{
+					{
+						ID:               "1",
+						Title:            "Throughput",
+						Layout:           entities.DashboardWidgetLayout{Column: 1, Row: 1, Width: 4, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "viz.line"},
+						RawConfiguration: []byte(`{"nrqlQueries":[{"accountId":1,"query":"SELECT count(*) FROM Transaction TIMESERIES"}],"yAxisLeft":{"zero":true},"legend":{"enabled":true}}`),
+					},
+					{
+						ID:               "2",
+						Title:            "Errors by host",
+						Layout:           entities.DashboardWidgetLayout{Column: 5, Row: 1, Width: 8, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "viz.table"},
+						LinkedEntities:   []entities.EntityOutlineInterface{&entities.DashboardEntityOutline{GUID: "MXxWSVp8REFTSEJPQVJEfDI"}},
+						RawConfiguration: []byte(`{"nrqlQueries":[{"accountIds":[1,2],"query":"SELECT count(*) FROM TransactionError FACET host"}],"dataFormatters":[{"name":"count","type":"humanized"}]}`),
+					},
+					{
+						ID:               "3",
+						Title:            "Map",
+						Layout:           entities.DashboardWidgetLayout{Column: 1, Row: 4, Width: 6, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map"},
+						RawConfiguration: []byte(`{"zoom":3}`),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildDashboardConvertHCL(t *testing.T) {
+	file, unconverted := buildDashboardConvertHCL(testDashboardConvertEntity(), "checkout_service")
+
+	require.Equal(t, []string{
+		"Overview / Errors by host (dataFormatters)",
+		"Overview / Errors by host (nrqlQueries[0].accountIds)",
+		"Overview / Map (0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map)",
+	}, unconverted)
+
+	expected := `# Widget "Errors by host" of page "Overview" loses its dataFormatters, nrqlQueries[0].accountIds configuration as a widget_table.
+# Widget "Map" of page "Overview" uses the 0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map visualization, which has no widget block, it is left out.
+resource "newrelic_one_dashboard" "checkout_service" {
+  name = "Checkout Service"
+
+  page {
+    name = "Overview"
+
+    widget_line {
+      title            = "Throughput"
+      row              = 1
+      column           = 1
+      y_axis_left_zero = true
+      nrql_query {
+        account_id = 1
+        query      = "SELECT count(*) FROM Transaction TIMESERIES"
+      }
+    }
+
+    widget_table {
+      title                    = "Errors by host"
+      row                      = 1
+      column                   = 5
+      width                    = 8
+      filter_current_dashboard = true
+      nrql_query {
+        query = "SELECT count(*) FROM TransactionError FACET host"
+      }
+    }
+  }
+}
+`
+	require.Equal(t, expected, string(hclwrite.Format(file.Bytes())))
+}
+
+func TestDashboardConvertLostConfiguration_Billboard(t *testing.T) {
+	widgetSchema := dashboardWidgetBillboardSchemaElem().Schema
+
+	lost := dashboardConvertLostConfiguration("viz.billboard", []byte(`{"nrqlQueries":[{"accountId":1,"query":"SELECT 1"}],"thresholds":[{"alertSeverity":"CRITICAL","value":2},{"alertSeverity":"NOT_ALERTING","value":1}]}`), widgetSchema)
+	require.Equal(t, []string{"thresholds[1]"}, lost)
+
+	require.Equal(t, []string{"rawConfiguration"}, dashboardConvertLostConfiguration("viz.billboard", []byte(`not json`), widgetSchema))
+}
@@ -0,0 +1,397 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// dashboardExportDocument is the JSON document accepted by newrelic_one_dashboard_json, with
+// its fields in the order the New Relic UI exports them.
+type dashboardExportDocument struct {
+	Name        string                       `json:"name"`
+	Description string                       `json:"description,omitempty"`
+	Permissions string                       `json:"permissions"`
+	Pages       []dashboardExportPage        `json:"pages"`
+	Variables   []entities.DashboardVariable `json:"variables,omitempty"`
+}
+
+type dashboardExportPage struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	Widgets     []dashboardExportWidget `json:"widgets"`
+}
+
+type dashboardExportWidget struct {
+	Title             string                       `json:"title"`
+	Layout            dashboardExportLayout        `json:"layout"`
+	LinkedEntityGUIDs []string                     `json:"linkedEntityGuids,omitempty"`
+	Visualization     dashboardExportVisualization `json:"visualization"`
+	RawConfiguration  json.RawMessage              `json:"rawConfiguration"`
+}
+
+type dashboardExportLayout struct {
+	Column int `json:"column"`
+	Row    int `json:"row"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type dashboardExportVisualization struct {
+	ID string `json:"id"`
+}
+
+// The attributes written first in exported HCL blocks, the others follow in alphabetical order.
+var dashboardExportHCLLeadingAttributes = []string{"name", "title", "description", "permissions", "row", "column", "width", "height"}
+
+var dashboardExportResourceNameInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// buildDashboardExportJSON renders a dashboard as a document for newrelic_one_dashboard_json.
+// Widgets keep their raw configuration, so every visualization is exported as it is.
+func buildDashboardExportJSON(dashboard *entities.DashboardEntity) (string, error) {
+	pageGUIDs := dashboardExportPageGUIDs(dashboard)
+
+	document := dashboardExportDocument{
+		Name:        dashboard.Name,
+		Description: dashboard.Description,
+		Permissions: string(dashboard.Permissions),
+		Pages:       make([]dashboardExportPage, len(dashboard.Pages)),
+		Variables:   dashboard.Variables,
+	}
+
+	for i, p := range dashboard.Pages {
+		page := dashboardExportPage{
+			Name:        p.Name,
+			Description: p.Description,
+			Widgets:     make([]dashboardExportWidget, len(p.Widgets)),
+		}
+
+		for j, w := range p.Widgets {
+			widget := dashboardExportWidget{
+				Title: w.Title,
+				Layout: dashboardExportLayout{
+					Column: w.Layout.Column,
+					Row:    w.Layout.Row,
+					Width:  w.Layout.Width,
+					Height: w.Layout.Height,
+				},
+				Visualization:    dashboardExportVisualization{ID: w.Visualization.ID},
+				RawConfiguration: json.RawMessage("{}"),
+			}
+
+			// Pages of the exported dashboard won't exist in the dashboard created from the document
+			for _, guid := range flattenLinkedEntityGUIDs(w.LinkedEntities) {
+				if !pageGUIDs[guid] {
+					widget.LinkedEntityGUIDs = append(widget.LinkedEntityGUIDs, guid)
+				}
+			}
+
+			if len(w.RawConfiguration) > 0 && json.Valid(w.RawConfiguration) {
+				widget.RawConfiguration = json.RawMessage(w.RawConfiguration)
+			}
+
+			page.Widgets[j] = widget
+		}
+
+		document.Pages[i] = page
+	}
+
+	b, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func dashboardExportPageGUIDs(dashboard *entities.DashboardEntity) map[string]bool {
+	guids := map[string]bool{}
+	for _, p := range dashboard.Pages {
+		guids[string(p.GUID)] = true
+	}
+
+	return guids
+}
+
+// buildDashboardExportHCL renders a dashboard as a newrelic_one_dashboard resource. Widgets with
+// a visualization without a widget_* block are converted to widget_json blocks showing their
+// queries, and are returned as unsupported along with the widgets that could not be converted.
+func buildDashboardExportHCL(dashboard *entities.DashboardEntity, resourceName string) (*hclwrite.File, []string) {
+	unsupported := []string{}
+	comments := []string{}
+	pageGUIDs := dashboardExportPageGUIDs(dashboard)
+	pages := flattenDashboardPage(&dashboard.Pages)
+
+	for i, p := range dashboard.Pages {
+		page := pages[i].(map[string]interface{})
+
+		// Widgets linked to their own page use filter_current_dashboard, links to the other
+		// pages of the exported dashboard are dropped like in the JSON document
+		for k, widgets := range page {
+			if !strings.HasPrefix(k, "widget_") {
+				continue
+			}
+
+			for _, w := range widgets.([]interface{}) {
+				widget := w.(map[string]interface{})
+				guids, ok := widget["linked_entity_guids"].([]string)
+				if !ok {
+					continue
+				}
+
+				linked := []string{}
+				for _, guid := range guids {
+					if !pageGUIDs[guid] {
+						linked = append(linked, guid)
+					}
+				}
+				widget["linked_entity_guids"] = linked
+			}
+		}
+
+		for _, w := range p.Widgets {
+			widget := w
+			widgetType, out := flattenDashboardWidget(&widget, string(p.GUID))
+			if widgetType != "" {
+				continue
+			}
+
+			description := fmt.Sprintf("%s / %s (%s)", p.Name, w.Title, w.Visualization.ID)
+			unsupported = append(unsupported, description)
+
+			rawCfg := dashboards.RawConfiguration{}
+			if len(w.RawConfiguration) > 0 {
+				if err := json.Unmarshal(w.RawConfiguration, &rawCfg); err != nil {
+					log.Printf("[WARN] Error parsing the configuration of widget %s: %s", w.ID, err)
+				}
+			}
+
+			if len(rawCfg.NRQLQueries) == 0 {
+				comments = append(comments, fmt.Sprintf("Widget %q of page %q uses the %s visualization and has no NRQL queries, it can't be exported.", w.Title, p.Name, w.Visualization.ID))
+				continue
+			}
+
+			out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+			if _, ok := page["widget_json"]; !ok {
+				page["widget_json"] = []interface{}{}
+			}
+			page["widget_json"] = append(page["widget_json"].([]interface{}), out)
+			comments = append(comments, fmt.Sprintf("Widget %q of page %q uses the %s visualization, it is exported as a widget_json.", w.Title, p.Name, w.Visualization.ID))
+		}
+	}
+
+	attributes := map[string]interface{}{
+		"name":        dashboard.Name,
+		"description": dashboard.Description,
+		"permissions": strings.ToLower(string(dashboard.Permissions)),
+		"page":        pages,
+	}
+
+	if len(dashboard.Variables) > 0 {
+		attributes["variable"] = flattenDashboardVariable(&dashboard.Variables)
+	}
+
+	return buildDashboardHCL(attributes, comments, resourceName), unsupported
+}
+
+// buildDashboardHCL writes a flattened newrelic_one_dashboard resource, preceded by comments.
+func buildDashboardHCL(attributes map[string]interface{}, comments []string, resourceName string) *hclwrite.File {
+	file := hclwrite.NewEmptyFile()
+	for _, comment := range comments {
+		appendHCLComment(file.Body(), comment)
+	}
+
+	resource := file.Body().AppendNewBlock("resource", []string{"newrelic_one_dashboard", resourceName}).Body()
+	appendDashboardExportHCLBody(resource, resourceNewRelicOneDashboard().Schema, attributes)
+
+	return file
+}
+
+// appendDashboardExportHCLBody writes the values of a flattened resource using its schema: values
+// are written as attributes or nested blocks, and optional values left unset or set to their
+// default are omitted.
+func appendDashboardExportHCLBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if _, ok := s[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return dashboardExportHCLKeyOrder(keys[i]) < dashboardExportHCLKeyOrder(keys[j])
+	})
+
+	blocks := []string{}
+	for _, k := range keys {
+		attr := s[k]
+		if attr.Computed && !attr.Optional && !attr.Required {
+			continue
+		}
+
+		if _, ok := attr.Elem.(*schema.Resource); ok {
+			if _, isList := values[k].([]interface{}); isList {
+				blocks = append(blocks, k)
+			}
+			continue
+		}
+
+		value, ok := dashboardExportCtyValue(values[k])
+		if !ok {
+			continue
+		}
+
+		if !attr.Required {
+			if attr.Default != nil && dashboardExportEqualsDefault(value, attr.Default) {
+				continue
+			}
+
+			if attr.Default == nil && dashboardExportIsZero(value) {
+				continue
+			}
+		}
+
+		body.SetAttributeValue(k, value)
+	}
+
+	for _, k := range blocks {
+		elem := s[k].Elem.(*schema.Resource)
+		for _, item := range values[k].([]interface{}) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// Pages and widgets are separated by blank lines, their nested blocks are kept together
+			if k == "page" || k == "variable" || strings.HasPrefix(k, "widget_") {
+				body.AppendNewline()
+			}
+			block := body.AppendNewBlock(k, nil).Body()
+			appendDashboardExportHCLBody(block, elem.Schema, m)
+		}
+	}
+}
+
+func dashboardExportHCLKeyOrder(k string) string {
+	for i, leading := range dashboardExportHCLLeadingAttributes {
+		if k == leading {
+			return fmt.Sprintf("0%02d", i)
+		}
+	}
+
+	return "1" + k
+}
+
+// dashboardExportCtyValue converts a flattened value to a cty value, returning false for values
+// that are not set.
+func dashboardExportCtyValue(v interface{}) (cty.Value, bool) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return cty.NilVal, false
+	}
+
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return cty.NilVal, false
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return cty.StringVal(rv.String()), true
+	case reflect.Bool:
+		return cty.BoolVal(rv.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cty.NumberIntVal(rv.Int()), true
+	case reflect.Float32, reflect.Float64:
+		return cty.NumberFloatVal(rv.Float()), true
+	case reflect.Slice:
+		if rv.Len() == 0 {
+			return cty.ListValEmpty(cty.String), true
+		}
+
+		items := []cty.Value{}
+		for i := 0; i < rv.Len(); i++ {
+			item, ok := dashboardExportCtyValue(rv.Index(i).Interface())
+			if !ok {
+				continue
+			}
+			items = append(items, item)
+		}
+
+		if len(items) == 0 {
+			return cty.ListValEmpty(cty.String), true
+		}
+
+		return cty.TupleVal(items), true
+	}
+
+	return cty.NilVal, false
+}
+
+func dashboardExportIsZero(value cty.Value) bool {
+	switch {
+	case value.Type() == cty.String:
+		return value.AsString() == ""
+	case value.Type() == cty.Bool:
+		return value.False()
+	case value.Type() == cty.Number:
+		return value.Equals(cty.Zero).True()
+	case value.Type().IsListType() || value.Type().IsTupleType():
+		return value.LengthInt() == 0
+	}
+
+	return false
+}
+
+func dashboardExportEqualsDefault(value cty.Value, def interface{}) bool {
+	d, ok := dashboardExportCtyValue(def)
+	if !ok || !d.Type().Equals(value.Type()) {
+		return false
+	}
+
+	return value.Equals(d).True()
+}
+
+// dashboardExportResourceName derives a Terraform resource name from a dashboard name.
+func dashboardExportResourceName(name string) string {
+	resourceName := strings.Trim(dashboardExportResourceNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+
+	if resourceName == "" || (resourceName[0] >= '0' && resourceName[0] <= '9') {
+		resourceName = "dashboard_" + resourceName
+	}
+
+	return strings.TrimSuffix(resourceName, "_")
+}
+
+func flattenDashboardExport(dashboard *entities.DashboardEntity, resourceName string, d *schema.ResourceData) error {
+	document, err := buildDashboardExportJSON(dashboard)
+	if err != nil {
+		return err
+	}
+
+	if resourceName == "" {
+		resourceName = dashboardExportResourceName(dashboard.Name)
+	}
+
+	file, unsupported := buildDashboardExportHCL(dashboard, resourceName)
+
+	d.SetId(string(dashboard.GUID))
+	_ = d.Set("account_id", dashboard.AccountID)
+	_ = d.Set("name", dashboard.Name)
+	_ = d.Set("permalink", dashboard.Permalink)
+	_ = d.Set("json", document)
+	_ = d.Set("hcl", string(hclwrite.Format(file.Bytes())))
+
+	return d.Set("unsupported_widgets", unsupported)
+}
@@ -0,0 +1,146 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/stretchr/testify/require"
+)
+
+func testDashboardExportEntity() *entities.DashboardEntity {
+	return &entities.DashboardEntity{
+		AccountID:   1,
+		GUID:        "MXxWSVp8REFTSEJPQVJEfDE",
+		Name:        "Checkout Service",
+		Permissions: entities.DashboardPermissionsTypes.PUBLIC_READ_WRITE,
+		Pages: []entities.DashboardPage{
+			{
+				GUID: "MXxWSVp8REFTSEJPQVJEfDI",
+				Name: "Overview",
+				Widgets: []entities.DashboardWidget{
+					{
+						ID:               "1",
+						Title:            "Throughput",
+						Layout:           entities.DashboardWidgetLayout{Column: 1, Row: 1, Width: 4, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "viz.billboard"},
+						RawConfiguration: []byte(`{"nrqlQueries":[{"accountId":1,"query":"SELECT rate(count(*), 1 minute) FROM Transaction"}],"thresholds":[{"alertSeverity":"CRITICAL","value":100}]}`),
+					},
+					{
+						ID:               "2",
+						Title:            "Errors by host",
+						Layout:           entities.DashboardWidgetLayout{Column: 5, Row: 1, Width: 8, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "viz.bar"},
+						LinkedEntities:   []entities.EntityOutlineInterface{&entities.DashboardEntityOutline{GUID: "MXxWSVp8REFTSEJPQVJEfDI"}},
+						RawConfiguration: []byte(`{"nrqlQueries":[{"accountId":1,"query":"SELECT count(*) FROM TransactionError FACET host"}]}`),
+					},
+					{
+						ID:               "3",
+						Title:            "Traffic",
+						Layout:           entities.DashboardWidgetLayout{Column: 1, Row: 4, Width: 12, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "viz.area-stacked"},
+						RawConfiguration: []byte(`{"nrqlQueries":[{"accountId":1,"query":"SELECT count(*) FROM Transaction TIMESERIES"}],"legend":{"enabled":false}}`),
+					},
+					{
+						ID:               "4",
+						Title:            "Map",
+						Layout:           entities.DashboardWidgetLayout{Column: 1, Row: 7, Width: 6, Height: 3},
+						Visualization:    entities.DashboardWidgetVisualization{ID: "0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map"},
+						RawConfiguration: []byte(`{"zoom":3}`),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildDashboardExportJSON(t *testing.T) {
+	document, err := buildDashboardExportJSON(testDashboardExportEntity())
+	require.NoError(t, err)
+
+	changes, err := dashboardJSONChanges(document, `{
+	  "name": "Checkout Service",
+	  "permissions": "PUBLIC_READ_WRITE",
+	  "pages": [{
+	    "name": "Overview",
+	    "widgets": [
+	      {"title": "Throughput", "layout": {"column": 1, "row": 1, "width": 4, "height": 3}, "visualization": {"id": "viz.billboard"},
+	       "rawConfiguration": {"nrqlQueries": [{"accountId": 1, "query": "SELECT rate(count(*), 1 minute) FROM Transaction"}], "thresholds": [{"alertSeverity": "CRITICAL", "value": 100}]}},
+	      {"title": "Errors by host", "layout": {"column": 5, "row": 1, "width": 8, "height": 3}, "visualization": {"id": "viz.bar"},
+	       "rawConfiguration": {"nrqlQueries": [{"accountId": 1, "query": "SELECT count(*) FROM TransactionError FACET host"}]}},
+	      {"title": "Traffic", "layout": {"column": 1, "row": 4, "width": 12, "height": 3}, "visualization": {"id": "viz.area-stacked"},
+	       "rawConfiguration": {"nrqlQueries": [{"accountId": 1, "query": "SELECT count(*) FROM Transaction TIMESERIES"}], "legend": {"enabled": false}}},
+	      {"title": "Map", "layout": {"column": 1, "row": 7, "width": 6, "height": 3}, "visualization": {"id": "0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map"},
+	       "rawConfiguration": {"zoom": 3}}
+	    ]
+	  }]
+	}`)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestBuildDashboardExportHCL(t *testing.T) {
+	file, unsupported := buildDashboardExportHCL(testDashboardExportEntity(), "checkout_service")
+
+	require.Equal(t, []string{
+		"Overview / Traffic (viz.area-stacked)",
+		"Overview / Map (0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map)",
+	}, unsupported)
+
+	expected := `# Widget "Traffic" of page "Overview" uses the viz.area-stacked visualization, it is exported as a widget_json.
+# Widget "Map" of page "Overview" uses the 0c3ae7ec-1b2c-4d5e-8f90-0a1b2c3d4e5f.map visualization and has no NRQL queries, it can't be exported.
+resource "newrelic_one_dashboard" "checkout_service" {
+  name        = "Checkout Service"
+  permissions = "public_read_write"
+
+  page {
+    name = "Overview"
+
+    widget_bar {
+      title                    = "Errors by host"
+      row                      = 1
+      column                   = 5
+      width                    = 8
+      filter_current_dashboard = true
+      nrql_query {
+        account_id = 1
+        query      = "SELECT count(*) FROM TransactionError FACET host"
+      }
+    }
+
+    widget_billboard {
+      title    = "Throughput"
+      row      = 1
+      column   = 1
+      critical = "100"
+      nrql_query {
+        account_id = 1
+        query      = "SELECT rate(count(*), 1 minute) FROM Transaction"
+      }
+    }
+
+    widget_json {
+      title          = "Traffic"
+      row            = 4
+      column         = 1
+      width          = 12
+      legend_enabled = false
+      nrql_query {
+        account_id = 1
+        query      = "SELECT count(*) FROM Transaction TIMESERIES"
+      }
+    }
+  }
+}
+`
+	require.Equal(t, expected, string(hclwrite.Format(file.Bytes())))
+}
+
+func TestDashboardExportResourceName(t *testing.T) {
+	require.Equal(t, "checkout_service", dashboardExportResourceName("Checkout Service"))
+	require.Equal(t, "dashboard_2023_kpis", dashboardExportResourceName("2023 KPIs!"))
+	require.Equal(t, "dashboard", dashboardExportResourceName("📈"))
+}
@@ -0,0 +1,496 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
+)
+
+// grafanaDashboard is the part of a Grafana dashboard JSON model that is converted.
+type grafanaDashboard struct {
+	UID         string            `json:"uid"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Panels      []grafanaPanel    `json:"panels"`
+	Rows        []json.RawMessage `json:"rows"`
+	Templating  struct {
+		List []grafanaVariable `json:"list"`
+	} `json:"templating"`
+}
+
+type grafanaPanel struct {
+	Type            string             `json:"type"`
+	Title           string             `json:"title"`
+	GridPos         grafanaGridPos     `json:"gridPos"`
+	Targets         []grafanaTarget    `json:"targets"`
+	Panels          []grafanaPanel     `json:"panels"`
+	Repeat          string             `json:"repeat"`
+	Content         string             `json:"content"`
+	Mode            string             `json:"mode"`
+	Options         grafanaPanelOption `json:"options"`
+	Transformations []struct {
+		ID string `json:"id"`
+	} `json:"transformations"`
+	FieldConfig struct {
+		Defaults struct {
+			Max        *float64 `json:"max"`
+			Unit       string   `json:"unit"`
+			Thresholds struct {
+				Steps []grafanaThresholdStep `json:"steps"`
+			} `json:"thresholds"`
+		} `json:"defaults"`
+		Overrides []json.RawMessage `json:"overrides"`
+	} `json:"fieldConfig"`
+}
+
+type grafanaPanelOption struct {
+	Content string `json:"content"`
+	Mode    string `json:"mode"`
+}
+
+type grafanaGridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type grafanaTarget struct {
+	RefID        string `json:"refId"`
+	Expr         string `json:"expr"`
+	Hide         bool   `json:"hide"`
+	LegendFormat string `json:"legendFormat"`
+}
+
+type grafanaThresholdStep struct {
+	Color string   `json:"color"`
+	Value *float64 `json:"value"`
+}
+
+type grafanaVariable struct {
+	Name    string          `json:"name"`
+	Label   string          `json:"label"`
+	Type    string          `json:"type"`
+	Query   json.RawMessage `json:"query"`
+	Multi   bool            `json:"multi"`
+	Current struct {
+		Value json.RawMessage `json:"value"`
+	} `json:"current"`
+}
+
+// The Grafana panel types translated, and the visualizations they are translated to.
+var grafanaPanelVisualizations = map[string]string{
+	"gauge":      "viz.bullet",
+	"graph":      "viz.line",
+	"singlestat": "viz.billboard",
+	"stat":       "viz.billboard",
+	"table":      "viz.table",
+	"text":       "viz.markdown",
+	"timeseries": "viz.line",
+}
+
+// The colors of Grafana threshold steps and the billboard severities they are translated to.
+var grafanaThresholdSeverities = map[string]string{
+	"dark-red":      "CRITICAL",
+	"red":           "CRITICAL",
+	"semi-dark-red": "CRITICAL",
+	"dark-orange":   "WARNING",
+	"orange":        "WARNING",
+	"yellow":        "WARNING",
+	"dark-yellow":   "WARNING",
+}
+
+var grafanaLabelValuesQuery = regexp.MustCompile(`^\s*label_values\(\s*(?:([a-zA-Z_:][a-zA-Z0-9_:]*)\s*,\s*)?([a-zA-Z_][a-zA-Z0-9_]*)\s*\)\s*$`)
+
+// Grafana's grid has 24 columns and rows of 30 pixels, a panel of the default height of 8 rows
+// is about as tall as a widget of 3 rows.
+const (
+	grafanaGridColumns = 24
+	grafanaGridRows    = 8
+	grafanaWidgetRows  = 3
+)
+
+// grafanaDashboardConversion is a Grafana dashboard translated to a document for
+// newrelic_one_dashboard_json, along with everything that could not be translated.
+type grafanaDashboardConversion struct {
+	Document     dashboardExportDocument
+	Untranslated []string
+}
+
+// convertGrafanaDashboard translates a Grafana dashboard JSON model to a New Relic dashboard of
+// a single page. Queries are translated from PromQL to NRQL and run against the given account.
+func convertGrafanaDashboard(grafanaJSON string, accountID int) (*grafanaDashboardConversion, error) {
+	var grafana grafanaDashboard
+	if err := json.Unmarshal([]byte(grafanaJSON), &grafana); err != nil {
+		return nil, fmt.Errorf("error parsing the Grafana dashboard: %w", err)
+	}
+
+	name := grafana.Title
+	if name == "" {
+		name = "Grafana dashboard"
+	}
+
+	conversion := &grafanaDashboardConversion{
+		Document: dashboardExportDocument{
+			Name:        name,
+			Description: grafana.Description,
+			Permissions: string(entities.DashboardPermissionsTypes.PUBLIC_READ_WRITE),
+		},
+		Untranslated: []string{},
+	}
+
+	if len(grafana.Rows) > 0 {
+		conversion.untranslated("dashboard: the rows of dashboards older than Grafana 5 are not translated, save the dashboard with a recent Grafana version first")
+	}
+
+	for _, v := range grafana.Templating.List {
+		if variable := conversion.convertVariable(v, accountID); variable != nil {
+			conversion.Document.Variables = append(conversion.Document.Variables, *variable)
+		}
+	}
+
+	widgets := []dashboardExportWidget{}
+	for _, p := range grafanaDashboardPanels(grafana.Panels) {
+		if widget := conversion.convertPanel(p, accountID); widget != nil {
+			widgets = append(widgets, *widget)
+		}
+	}
+
+	if err := placeGrafanaDashboardWidgets(widgets); err != nil {
+		return nil, err
+	}
+
+	conversion.Document.Pages = []dashboardExportPage{{Name: name, Widgets: widgets}}
+
+	return conversion, nil
+}
+
+func (c *grafanaDashboardConversion) untranslated(format string, a ...interface{}) {
+	c.Untranslated = append(c.Untranslated, fmt.Sprintf(format, a...))
+}
+
+// grafanaDashboardPanels lists the panels of a dashboard, along with the panels of its collapsed
+// rows. Rows themselves have no equivalent on a dashboard page and are left out.
+func grafanaDashboardPanels(panels []grafanaPanel) []grafanaPanel {
+	out := []grafanaPanel{}
+	for _, p := range panels {
+		if p.Type == "row" {
+			out = append(out, grafanaDashboardPanels(p.Panels)...)
+			continue
+		}
+		out = append(out, p)
+	}
+
+	return out
+}
+
+func (c *grafanaDashboardConversion) convertPanel(p grafanaPanel, accountID int) *dashboardExportWidget {
+	visualizationID, ok := grafanaPanelVisualizations[p.Type]
+	if !ok {
+		c.untranslated("panel %q: the %s panel type has no equivalent visualization", p.Title, p.Type)
+		return nil
+	}
+
+	if p.Repeat != "" {
+		c.untranslated("panel %q: the panel is not repeated for each value of $%s", p.Title, p.Repeat)
+	}
+
+	rawConfiguration := map[string]interface{}{}
+
+	if visualizationID == "viz.markdown" {
+		content, mode := p.Options.Content, p.Options.Mode
+		if content == "" {
+			content, mode = p.Content, p.Mode
+		}
+		if mode == "html" {
+			c.untranslated("panel %q: HTML content is kept as is in a markdown widget", p.Title)
+		}
+		rawConfiguration["text"] = content
+	} else {
+		queries := c.convertPanelQueries(p, accountID, visualizationID == "viz.line")
+		if len(queries) == 0 {
+			c.untranslated("panel %q: no query of the panel could be translated, the panel is left out", p.Title)
+			return nil
+		}
+		rawConfiguration["nrqlQueries"] = queries
+		c.reportPanelFieldConfig(p, visualizationID)
+	}
+
+	switch visualizationID {
+	case "viz.billboard":
+		if thresholds := c.convertPanelThresholds(p); len(thresholds) > 0 {
+			rawConfiguration["thresholds"] = thresholds
+		}
+	case "viz.bullet":
+		if p.FieldConfig.Defaults.Max != nil {
+			rawConfiguration["limit"] = *p.FieldConfig.Defaults.Max
+		}
+	}
+
+	b, err := json.Marshal(rawConfiguration)
+	if err != nil {
+		c.untranslated("panel %q: %s", p.Title, err)
+		return nil
+	}
+
+	return &dashboardExportWidget{
+		Title:            p.Title,
+		Layout:           convertGrafanaGridPos(p.GridPos),
+		Visualization:    dashboardExportVisualization{ID: visualizationID},
+		RawConfiguration: b,
+	}
+}
+
+func (c *grafanaDashboardConversion) convertPanelQueries(p grafanaPanel, accountID int, timeseries bool) []map[string]interface{} {
+	queries := []map[string]interface{}{}
+
+	for _, t := range p.Targets {
+		if t.Hide {
+			continue
+		}
+
+		if strings.TrimSpace(t.Expr) == "" {
+			c.untranslated("panel %q: query %s is not a PromQL query", p.Title, t.RefID)
+			continue
+		}
+
+		nrql, notes, err := translateGrafanaPromQL(t.Expr, timeseries)
+		if err != nil {
+			c.untranslated("panel %q: query %s: %s", p.Title, t.RefID, err)
+			continue
+		}
+
+		for _, note := range notes {
+			c.untranslated("panel %q: query %s: %s", p.Title, t.RefID, note)
+		}
+
+		if t.LegendFormat != "" && t.LegendFormat != "__auto" {
+			c.untranslated("panel %q: query %s: the legend format %q is not translated, series are named after their facets", p.Title, t.RefID, t.LegendFormat)
+		}
+
+		queries = append(queries, map[string]interface{}{
+			"accountId": accountID,
+			"query":     nrql,
+		})
+	}
+
+	return queries
+}
+
+// reportPanelFieldConfig reports the field options of a panel which have no equivalent in the
+// configuration of widgets: units, overrides, transformations and thresholds of other panels
+// than stat panels.
+func (c *grafanaDashboardConversion) reportPanelFieldConfig(p grafanaPanel, visualizationID string) {
+	defaults := p.FieldConfig.Defaults
+
+	if defaults.Unit != "" && defaults.Unit != "none" && defaults.Unit != "short" {
+		c.untranslated("panel %q: the %s unit is not translated, values are shown as returned by the query", p.Title, defaults.Unit)
+	}
+
+	if visualizationID != "viz.billboard" {
+		for _, step := range defaults.Thresholds.Steps {
+			if step.Value != nil {
+				c.untranslated("panel %q: thresholds are only translated for stat panels", p.Title)
+				break
+			}
+		}
+	}
+
+	if len(p.FieldConfig.Overrides) > 0 {
+		c.untranslated("panel %q: the field overrides (%d) are not translated", p.Title, len(p.FieldConfig.Overrides))
+	}
+
+	if len(p.Transformations) > 0 {
+		ids := make([]string, len(p.Transformations))
+		for i, t := range p.Transformations {
+			ids[i] = t.ID
+		}
+		c.untranslated("panel %q: the %s transformations are not translated", p.Title, strings.Join(ids, ", "))
+	}
+}
+
+// convertPanelThresholds translates the threshold steps of stat panels to billboard thresholds,
+// the first step colored as a critical or warning threshold sets its value.
+func (c *grafanaDashboardConversion) convertPanelThresholds(p grafanaPanel) []map[string]interface{} {
+	thresholds := []map[string]interface{}{}
+	seen := map[string]bool{}
+
+	for _, step := range p.FieldConfig.Defaults.Thresholds.Steps {
+		severity, ok := grafanaThresholdSeverities[step.Color]
+		if !ok || seen[severity] {
+			continue
+		}
+
+		if step.Value == nil {
+			c.untranslated("panel %q: the %s base threshold has no value to alert on", p.Title, step.Color)
+			continue
+		}
+
+		seen[severity] = true
+		thresholds = append(thresholds, map[string]interface{}{
+			"alertSeverity": severity,
+			"value":         *step.Value,
+		})
+	}
+
+	return thresholds
+}
+
+func convertGrafanaGridPos(pos grafanaGridPos) dashboardExportLayout {
+	layout := dashboardExportLayout{
+		Column: pos.X*dashboardGridColumns/grafanaGridColumns + 1,
+		Row:    pos.Y*grafanaWidgetRows/grafanaGridRows + 1,
+		Width:  (pos.W*dashboardGridColumns + grafanaGridColumns - 1) / grafanaGridColumns,
+		Height: (pos.H*grafanaWidgetRows + grafanaGridRows - 1) / grafanaGridRows,
+	}
+
+	if layout.Width < 1 {
+		layout.Width = 4
+	}
+	if layout.Height < 1 {
+		layout.Height = grafanaWidgetRows
+	}
+	if layout.Width > dashboardGridColumns {
+		layout.Width = dashboardGridColumns
+	}
+	if layout.Column+layout.Width-1 > dashboardGridColumns {
+		layout.Column = dashboardGridColumns - layout.Width + 1
+	}
+
+	return layout
+}
+
+// placeGrafanaDashboardWidgets moves the widgets overlapping the widgets before them, as
+// panels placed closer than the coarser grid of dashboards allows, to the first free space.
+func placeGrafanaDashboardWidgets(widgets []dashboardExportWidget) error {
+	placements := []dashboardWidgetPlacement{}
+	inputs := make([]dashboards.DashboardWidgetInput, len(widgets))
+
+	for i, w := range widgets {
+		placement := dashboardWidgetPlacement{Name: w.Title, Row: w.Layout.Row, Column: w.Layout.Column, Width: w.Layout.Width, Height: w.Layout.Height}
+		inputs[i] = dashboards.DashboardWidgetInput{
+			Title:  w.Title,
+			Layout: dashboards.DashboardWidgetLayoutInput{Row: placement.Row, Column: placement.Column, Width: placement.Width, Height: placement.Height},
+		}
+
+		for _, o := range placements {
+			if placement.overlaps(o) {
+				inputs[i].Layout.Row, inputs[i].Layout.Column = 0, 0
+				break
+			}
+		}
+
+		if inputs[i].Layout.Row > 0 {
+			placements = append(placements, placement)
+		}
+	}
+
+	if err := placeDashboardWidgets(inputs); err != nil {
+		return err
+	}
+
+	for i := range widgets {
+		widgets[i].Layout.Row = inputs[i].Layout.Row
+		widgets[i].Layout.Column = inputs[i].Layout.Column
+	}
+
+	return nil
+}
+
+func (c *grafanaDashboardConversion) convertVariable(v grafanaVariable, accountID int) *entities.DashboardVariable {
+	variable := &entities.DashboardVariable{
+		Name:  v.Name,
+		Title: v.Label,
+	}
+
+	query := grafanaVariableQuery(v.Query)
+
+	switch v.Type {
+	case "custom":
+		variable.Type = entities.DashboardVariableTypeTypes.ENUM
+		variable.IsMultiSelection = v.Multi
+		for _, option := range strings.Split(query, ",") {
+			item := entities.DashboardVariableEnumItem{Value: strings.TrimSpace(option)}
+			if parts := strings.SplitN(option, " : ", 2); len(parts) == 2 {
+				item = entities.DashboardVariableEnumItem{Title: strings.TrimSpace(parts[0]), Value: strings.TrimSpace(parts[1])}
+			}
+			if item.Value != "" {
+				variable.Items = append(variable.Items, item)
+			}
+		}
+	case "constant", "textbox":
+		variable.Type = entities.DashboardVariableTypeTypes.STRING
+		if v.Type == "constant" && query != "" {
+			variable.DefaultValues = &[]entities.DashboardVariableDefaultItem{{Value: entities.DashboardVariableDefaultValue{String: query}}}
+			return variable
+		}
+	case "query":
+		match := grafanaLabelValuesQuery.FindStringSubmatch(query)
+		if match == nil {
+			c.untranslated("variable %q: the query %q is not a label_values query", v.Name, query)
+			return nil
+		}
+
+		nrql := fmt.Sprintf("SELECT uniques(%s) FROM Metric", grafanaNRQLIdentifierOf(match[2]))
+		if match[1] != "" {
+			nrql += fmt.Sprintf(" WHERE metricName = '%s'", match[1])
+		}
+
+		variable.Type = entities.DashboardVariableTypeTypes.NRQL
+		variable.IsMultiSelection = v.Multi
+		variable.NRQLQuery = &entities.DashboardVariableNRQLQuery{
+			AccountIDs: []int{accountID},
+			Query:      nrdb.NRQL(nrql),
+		}
+	default:
+		c.untranslated("variable %q: the %s variable type has no equivalent", v.Name, v.Type)
+		return nil
+	}
+
+	if defaults := grafanaVariableDefaultValues(v.Current.Value); len(defaults) > 0 {
+		variable.DefaultValues = &defaults
+	}
+
+	return variable
+}
+
+// The query of a variable is a string, or an object holding the string in recent Grafana versions.
+func grafanaVariableQuery(raw json.RawMessage) string {
+	var query string
+	if err := json.Unmarshal(raw, &query); err == nil {
+		return query
+	}
+
+	var object struct {
+		Query string `json:"query"`
+	}
+	_ = json.Unmarshal(raw, &object)
+
+	return object.Query
+}
+
+// The current value of a variable is a string, or a list of strings for variables with multiple
+// values. Selecting every value has no default value equivalent.
+func grafanaVariableDefaultValues(raw json.RawMessage) []entities.DashboardVariableDefaultItem {
+	values := []string{}
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		values = append(values, value)
+	} else {
+		_ = json.Unmarshal(raw, &values)
+	}
+
+	defaults := []entities.DashboardVariableDefaultItem{}
+	for _, v := range values {
+		if v != "" && v != "$__all" {
+			defaults = append(defaults, entities.DashboardVariableDefaultItem{Value: entities.DashboardVariableDefaultValue{String: v}})
+		}
+	}
+
+	return defaults
+}
@@ -0,0 +1,110 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGrafanaDashboardJSON = `{
+  "uid": "node-exporter",
+  "title": "Node Exporter",
+  "templating": {
+    "list": [
+      {"name": "instance", "label": "Instance", "type": "query", "query": {"query": "label_values(node_load1, instance)"}, "multi": true, "current": {"value": ["$__all"]}},
+      {"name": "env", "type": "custom", "query": "Production : prod,Staging : staging", "current": {"value": "prod"}},
+      {"name": "interval", "type": "interval", "query": "1m,5m"}
+    ]
+  },
+  "panels": [
+    {"type": "timeseries", "title": "Load", "gridPos": {"x": 0, "y": 0, "w": 12, "h": 8}, "targets": [{"refId": "A", "expr": "avg by (instance) (node_load1{instance=~\"$instance\"})", "legendFormat": "{{instance}}"}],
+      "fieldConfig": {"defaults": {"unit": "percent", "thresholds": {"steps": [{"color": "green", "value": null}, {"color": "red", "value": 80}]}}, "overrides": [{"matcher": {"id": "byName", "options": "idle"}, "properties": []}]},
+      "transformations": [{"id": "organize"}, {"id": "renameByRegex"}]},
+    {"type": "stat", "title": "Up", "gridPos": {"x": 12, "y": 0, "w": 6, "h": 8}, "targets": [{"refId": "A", "expr": "count(up)"}],
+      "fieldConfig": {"defaults": {"thresholds": {"steps": [{"color": "green", "value": null}, {"color": "orange", "value": 2}, {"color": "red", "value": 5}]}}}},
+    {"type": "gauge", "title": "Memory", "gridPos": {"x": 17, "y": 0, "w": 7, "h": 8}, "targets": [{"refId": "A", "expr": "max(node_memory_Active_bytes)"}], "fieldConfig": {"defaults": {"max": 100}}},
+    {"type": "row", "title": "Details", "collapsed": true, "gridPos": {"x": 0, "y": 8, "w": 24, "h": 1}, "panels": [
+      {"type": "table", "title": "Errors", "gridPos": {"x": 0, "y": 9, "w": 24, "h": 8}, "targets": [{"refId": "A", "expr": "sum(rate(http_requests_total{code=~\"5..\"}[5m])) by (code)"}, {"refId": "B", "expr": "a / b"}, {"refId": "C", "expr": "rate(node_cpu_seconds_total{mode!=\"idle\"}[5m])"}],
+        "fieldConfig": {"defaults": {"unit": "short"}}},
+      {"type": "text", "title": "Notes", "gridPos": {"x": 0, "y": 17, "w": 8, "h": 4}, "options": {"mode": "markdown", "content": "# Runbook"}}
+    ]},
+    {"type": "piechart", "title": "Share", "gridPos": {"x": 8, "y": 17, "w": 8, "h": 4}},
+    {"type": "timeseries", "title": "Loki", "gridPos": {"x": 16, "y": 17, "w": 8, "h": 4}, "targets": [{"refId": "A", "expr": "", "hide": false}]}
+  ]
+}`
+
+func TestConvertGrafanaDashboard(t *testing.T) {
+	conversion, err := convertGrafanaDashboard(testGrafanaDashboardJSON, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`variable "interval": the interval variable type has no equivalent`,
+		`panel "Load": query A: the legend format "{{instance}}" is not translated, series are named after their facets`,
+		`panel "Load": the percent unit is not translated, values are shown as returned by the query`,
+		`panel "Load": thresholds are only translated for stat panels`,
+		`panel "Load": the field overrides (1) are not translated`,
+		`panel "Load": the organize, renameByRegex transformations are not translated`,
+		`panel "Errors": query B: unsupported PromQL "/ b"`,
+		`panel "Errors": query C: the series of node_cpu_seconds_total are only kept apart by mode and summed otherwise, aggregate the query by the labels to keep apart`,
+		`panel "Share": the piechart panel type has no equivalent visualization`,
+		`panel "Loki": query A is not a PromQL query`,
+		`panel "Loki": no query of the panel could be translated, the panel is left out`,
+	}, conversion.Untranslated)
+
+	b, err := json.Marshal(conversion.Document)
+	require.NoError(t, err)
+	warnings, errs := validateDashboardJSON(string(b))
+	assert.Empty(t, warnings)
+	assert.Empty(t, errs)
+
+	document := conversion.Document
+	assert.Equal(t, "Node Exporter", document.Name)
+	assert.Equal(t, "PUBLIC_READ_WRITE", document.Permissions)
+
+	require.Len(t, document.Variables, 2)
+	assert.Equal(t, "NRQL", string(document.Variables[0].Type))
+	assert.Equal(t, "SELECT uniques(instance) FROM Metric WHERE metricName = 'node_load1'", string(document.Variables[0].NRQLQuery.Query))
+	assert.True(t, document.Variables[0].IsMultiSelection)
+	assert.Nil(t, document.Variables[0].DefaultValues)
+	assert.Equal(t, "ENUM", string(document.Variables[1].Type))
+	assert.Equal(t, "Production", document.Variables[1].Items[0].Title)
+	assert.Equal(t, "prod", document.Variables[1].Items[0].Value)
+	assert.Equal(t, "prod", (*document.Variables[1].DefaultValues)[0].Value.String)
+
+	require.Len(t, document.Pages, 1)
+	widgets := document.Pages[0].Widgets
+	require.Len(t, widgets, 5)
+
+	expected := []struct {
+		Title            string
+		Visualization    string
+		Layout           dashboardExportLayout
+		RawConfiguration string
+	}{
+		{"Load", "viz.line", dashboardExportLayout{Column: 1, Row: 1, Width: 6, Height: 3}, `{"nrqlQueries":[{"accountId":1,"query":"SELECT average(node_load1) FROM Metric WHERE instance IN ({{instance}}) FACET instance TIMESERIES"}]}`},
+		{"Up", "viz.billboard", dashboardExportLayout{Column: 7, Row: 1, Width: 3, Height: 3}, `{"nrqlQueries":[{"accountId":1,"query":"SELECT count(up) FROM Metric"}],"thresholds":[{"alertSeverity":"WARNING","value":2},{"alertSeverity":"CRITICAL","value":5}]}`},
+		// Overlaps the stat panel once its columns are halved, it moves to the first free space
+		{"Memory", "viz.bullet", dashboardExportLayout{Column: 5, Row: 7, Width: 4, Height: 3}, `{"limit":100,"nrqlQueries":[{"accountId":1,"query":"SELECT max(node_memory_Active_bytes) FROM Metric"}]}`},
+		{"Errors", "viz.table", dashboardExportLayout{Column: 1, Row: 4, Width: 12, Height: 3}, `{"nrqlQueries":[{"accountId":1,"query":"SELECT rate(sum(http_requests_total), 1 SECOND) FROM Metric WHERE code RLIKE r'5..' FACET code"},{"accountId":1,"query":"SELECT rate(sum(node_cpu_seconds_total), 1 SECOND) FROM Metric WHERE mode != 'idle' FACET mode"}]}`},
+		{"Notes", "viz.markdown", dashboardExportLayout{Column: 1, Row: 7, Width: 4, Height: 2}, `{"text":"# Runbook"}`},
+	}
+
+	for i, e := range expected {
+		assert.Equal(t, e.Title, widgets[i].Title)
+		assert.Equal(t, e.Visualization, widgets[i].Visualization.ID)
+		assert.Equal(t, e.Layout, widgets[i].Layout, e.Title)
+		assert.JSONEq(t, e.RawConfiguration, string(widgets[i].RawConfiguration))
+	}
+}
+
+func TestConvertGrafanaGridPos(t *testing.T) {
+	assert.Equal(t, dashboardExportLayout{Column: 1, Row: 1, Width: 12, Height: 3}, convertGrafanaGridPos(grafanaGridPos{X: 0, Y: 0, W: 24, H: 8}))
+	assert.Equal(t, dashboardExportLayout{Column: 7, Row: 7, Width: 3, Height: 2}, convertGrafanaGridPos(grafanaGridPos{X: 12, Y: 16, W: 5, H: 5}))
+	// Panels past the last column are kept on the grid
+	assert.Equal(t, dashboardExportLayout{Column: 9, Row: 1, Width: 4, Height: 3}, convertGrafanaGridPos(grafanaGridPos{X: 23, Y: 0, W: 7, H: 8}))
+}
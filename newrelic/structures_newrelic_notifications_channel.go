@@ -12,7 +12,7 @@ func expandNotificationChannel(d *schema.ResourceData) notifications.AiNotificat
 		Type:          notifications.AiNotificationsChannelType(d.Get("type").(string)),
 		Product:       notifications.AiNotificationsProduct(d.Get("product").(string)),
 	}
-	channel.Properties = expandNotificationChannelProperties(d.Get("property").(*schema.Set).List())
+	channel.Properties = notificationChannelTypedBlocks.mergeProperties(d, expandNotificationChannelProperties(d.Get("property").(*schema.Set).List()))
 
 	return channel
 }
@@ -22,7 +22,7 @@ func expandNotificationChannelUpdate(d *schema.ResourceData) notifications.AiNot
 		Name:   d.Get("name").(string),
 		Active: d.Get("active").(bool),
 	}
-	channel.Properties = expandNotificationDestinationProperties(d.Get("property").(*schema.Set).List())
+	channel.Properties = notificationChannelTypedBlocks.mergeProperties(d, expandNotificationDestinationProperties(d.Get("property").(*schema.Set).List()))
 
 	return channel
 }
@@ -82,7 +82,12 @@ func flattenNotificationChannel(channel *notifications.AiNotificationsChannel, d
 		return err
 	}
 
-	if err := d.Set("property", flattenNotificationChannelProperties(channel.Properties)); err != nil {
+	properties, err := notificationChannelTypedBlocks.flattenProperties(d, channel.Properties)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("property", flattenNotificationChannelProperties(withoutMonitoringProperty(properties))); err != nil {
 		return err
 	}
 
@@ -121,3 +126,47 @@ func flattenNotificationChannelProperty(p notifications.AiNotificationsProperty)
 
 	return propertyResult
 }
+
+func flattenNotificationChannelDataSource(channel *notifications.AiNotificationsChannel, d *schema.ResourceData) error {
+	if channel == nil {
+		return nil
+	}
+
+	var err error
+
+	d.SetId(channel.ID)
+
+	if err = d.Set("name", channel.Name); err != nil {
+		return err
+	}
+
+	if err = d.Set("type", channel.Type); err != nil {
+		return err
+	}
+
+	if err = d.Set("product", channel.Product); err != nil {
+		return err
+	}
+
+	if err = d.Set("destination_id", channel.DestinationId); err != nil {
+		return err
+	}
+
+	if err := d.Set("property", flattenNotificationChannelProperties(channel.Properties)); err != nil {
+		return err
+	}
+
+	if err := d.Set("active", channel.Active); err != nil {
+		return err
+	}
+
+	if err := d.Set("account_id", channel.AccountID); err != nil {
+		return err
+	}
+
+	if err := d.Set("status", channel.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,11 +4,17 @@
 package newrelic
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExpandNotificationChannel(t *testing.T) {
@@ -124,3 +130,233 @@ func TestFlattenNotificationChannel(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandNotificationChannelTypedBlock(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+	d := r.TestResourceData()
+
+	require.NoError(t, d.Set("name", "jira-test"))
+	require.NoError(t, d.Set("type", "JIRA_CLASSIC"))
+	require.NoError(t, d.Set("jira", []interface{}{
+		map[string]interface{}{
+			"project":     "10000",
+			"issue_type":  "10004",
+			"summary":     "{{ annotations.title.[0] }}",
+			"description": "Issue ID: {{ issueId }}",
+		},
+	}))
+	require.NoError(t, d.Set("property", []interface{}{
+		map[string]interface{}{"key": "labels", "value": "alerts"},
+		map[string]interface{}{"key": "project", "value": "99999"},
+	}))
+
+	expanded := expandNotificationChannel(d)
+
+	assert.ElementsMatch(t, []notifications.AiNotificationsPropertyInput{
+		{Key: "labels", Value: "alerts"},
+		{Key: "project", Value: "10000"},
+		{Key: "issuetype", Value: "10004"},
+		{Key: "summary", Value: "{{ annotations.title.[0] }}"},
+		{Key: "description", Value: "Issue ID: {{ issueId }}"},
+	}, expanded.Properties)
+}
+
+func TestFlattenNotificationChannelTypedBlock(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+	d := r.TestResourceData()
+
+	require.NoError(t, d.Set("slack", []interface{}{
+		map[string]interface{}{"channel_id": "C012345"},
+	}))
+
+	err := flattenNotificationChannel(&notifications.AiNotificationsChannel{
+		Name: "slack-test",
+		Type: notifications.AiNotificationsChannelTypeTypes.SLACK,
+		Properties: []notifications.AiNotificationsProperty{
+			{Key: "channelId", Value: "C067890"},
+			{Key: "customDetailsSlack", Value: "issue id - {{issueId}}"},
+			{Key: "source", Value: "terraform", Label: "terraform-source-internal"},
+		},
+	}, d)
+	require.NoError(t, err)
+
+	assert.Equal(t, "C067890", d.Get("slack.0.channel_id"))
+	assert.Equal(t, "issue id - {{issueId}}", d.Get("slack.0.custom_details"))
+
+	// The property sent by the provider to mark the channels it manages is left out
+	assert.Empty(t, d.Get("property").(*schema.Set).List())
+}
+
+func TestNotificationChannelRequiresProperties(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+
+	config := map[string]interface{}{
+		"name":           "webhook-test",
+		"type":           "WEBHOOK",
+		"product":        "IINT",
+		"destination_id": "b1e90a32-23b7-4028-b2c7-ffbdfe103852",
+	}
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(config))
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail, "one of `jira,pagerduty_service,property,servicenow,slack,webhook` must be specified")
+
+	config["webhook"] = []interface{}{map[string]interface{}{"payload": "{ \"id\": {{ json issueId }} }"}}
+	assert.False(t, r.Validate(terraform.NewResourceConfigRaw(config)).HasError())
+}
+
+func TestNotificationChannelTypedBlockCustomizeDiff(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+
+	cases := map[string]struct {
+		Config      map[string]interface{}
+		ExpectedErr string
+	}{
+		"matching type": {
+			Config: map[string]interface{}{
+				"name":           "webhook-test",
+				"type":           "WEBHOOK",
+				"product":        "IINT",
+				"destination_id": "b1e90a32-23b7-4028-b2c7-ffbdfe103852",
+				"webhook":        []interface{}{map[string]interface{}{"payload": "{ \"id\": {{ json issueId }} }"}},
+			},
+		},
+		"mismatching type": {
+			Config: map[string]interface{}{
+				"name":           "webhook-test",
+				"type":           "EMAIL",
+				"product":        "IINT",
+				"destination_id": "b1e90a32-23b7-4028-b2c7-ffbdfe103852",
+				"webhook":        []interface{}{map[string]interface{}{"payload": "{ \"id\": {{ json issueId }} }"}},
+			},
+			ExpectedErr: "the `webhook` block cannot be used with channel type `EMAIL`, expected one of: WEBHOOK",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.Config), nil)
+
+			if tc.ExpectedErr != "" {
+				require.ErrorContains(t, err, tc.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateNotificationChannelTemplate(t *testing.T) {
+	cases := map[string]struct {
+		Value           string
+		ExpectedErr     string
+		ExpectedWarning string
+	}{
+		"plain text": {
+			Value: "10000",
+		},
+		"text template": {
+			Value: "{{#each entitiesData.names}}{{this}}{{#unless @last}}, {{/unless}}{{/each}}",
+		},
+		"json template": {
+			Value: `{"id": {{json issueId}}, "priority": "{{#if (eq priority "CRITICAL")}}P1{{else}}P3{{/if}}", "incidents": {{totalIncidents}}}`,
+		},
+		"unbalanced block": {
+			Value:       "{{#if issueTitle}}{{issueTitle}}",
+			ExpectedErr: "invalid template at line 1, column 1: block `if` is never closed",
+		},
+		"unknown helper": {
+			Value:           "{{#if (matches priority \"CRITICAL\")}}!{{/if}}",
+			ExpectedWarning: "unknown helper `matches` at line 1, column 1",
+		},
+		"invalid json": {
+			Value:           `{"id": {{issueId}}}`,
+			ExpectedWarning: "the template does not render a valid JSON document for a sample issue: invalid character '0' after object key:value pair\n\n{\"id\": 00000000-0000-0000-0000-000000000000}",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			warnings, err := validateNotificationChannelTemplate(tc.Value, isNotificationChannelJSONTemplate(tc.Value))
+
+			if tc.ExpectedErr != "" {
+				require.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tc.ExpectedWarning != "" {
+				require.Equal(t, []string{tc.ExpectedWarning}, warnings)
+				return
+			}
+
+			require.Empty(t, warnings)
+		})
+	}
+}
+
+func TestNotificationChannelTemplateDiagnostics(t *testing.T) {
+	r := resourceNewRelicNotificationChannel()
+
+	// Warnings are returned by the validation of the configuration, so they show in the plan
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "webhook-test",
+		"type":           "WEBHOOK",
+		"product":        "IINT",
+		"destination_id": "destination-id",
+		"property": []interface{}{
+			map[string]interface{}{"key": "payload", "value": `{"id": {{issueId}}}`},
+		},
+	}))
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "notification channel template may not render as expected", diags[0].Summary)
+
+	diags = r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "slack-test",
+		"type":           "SLACK",
+		"product":        "IINT",
+		"destination_id": "destination-id",
+		"slack": []interface{}{
+			map[string]interface{}{"channel_id": "C012345", "custom_details": "{{upper issueTitle}}"},
+		},
+	}))
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "unknown helper `upper` at line 1, column 1", diags[0].Detail)
+
+	diags = r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "webhook-test",
+		"type":           "WEBHOOK",
+		"product":        "IINT",
+		"destination_id": "destination-id",
+		"webhook": []interface{}{
+			map[string]interface{}{"payload": "{{#if issueTitle}}"},
+		},
+	}))
+	require.True(t, diags.HasError())
+	assert.Equal(t, "invalid notification channel template", diags[0].Summary)
+}
+
+func TestBuildNotificationChannelTestDiagnostics(t *testing.T) {
+	diags := buildNotificationChannelTestDiagnostics("channel-id", &notificationChannelTestResult{Result: "SUCCESS"})
+	assert.Empty(t, diags)
+
+	diags = buildNotificationChannelTestDiagnostics("channel-id", &notificationChannelTestResult{
+		Result:  "FAIL",
+		Details: "The webhook responded with 500",
+		Errors: []ai.AiNotificationsError{
+			{Type: "CONNECTION_ERROR", Description: "Connection refused", Details: "https://example.com"},
+		},
+	})
+
+	require.Len(t, diags, 2)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "test notification for channel channel-id failed with result `FAIL`", diags[0].Summary)
+	assert.Equal(t, "The webhook responded with 500", diags[0].Detail)
+	assert.Equal(t, "CONNECTION_ERROR: Connection refused", diags[1].Summary)
+}
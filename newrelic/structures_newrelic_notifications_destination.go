@@ -31,9 +31,13 @@ func expandNotificationDestination(d *schema.ResourceData) (*notifications.AiNot
 		destination.Auth = expandNotificationDestinationAuthToken(attr.([]interface{}))
 	}
 
+	if attr, ok := d.GetOk("pagerduty_service.0.service_key"); ok {
+		destination.Auth = expandNotificationDestinationPagerDutyServiceKey(attr.(string))
+	}
+
 	properties := d.Get("property")
 	props := properties.(*schema.Set).List()
-	destination.Properties = expandNotificationDestinationProperties(props)
+	destination.Properties = notificationDestinationTypedBlocks.mergeProperties(d, expandNotificationDestinationProperties(props))
 
 	return &destination, nil
 }
@@ -64,6 +68,15 @@ func expandNotificationDestinationAuthToken(authRaw []interface{}) *notification
 	return &authInput
 }
 
+func expandNotificationDestinationPagerDutyServiceKey(serviceKey string) *notifications.AiNotificationsCredentialsInput {
+	return expandNotificationDestinationAuthToken([]interface{}{
+		map[string]interface{}{
+			"prefix": "Token token=",
+			"token":  serviceKey,
+		},
+	})
+}
+
 func expandNotificationDestinationUpdate(d *schema.ResourceData) (*notifications.AiNotificationsDestinationUpdate, error) {
 	destination := notifications.AiNotificationsDestinationUpdate{
 		Name:   d.Get("name").(string),
@@ -78,9 +91,13 @@ func expandNotificationDestinationUpdate(d *schema.ResourceData) (*notifications
 		destination.Auth = expandNotificationDestinationAuthToken(attr.([]interface{}))
 	}
 
+	if attr, ok := d.GetOk("pagerduty_service.0.service_key"); ok {
+		destination.Auth = expandNotificationDestinationPagerDutyServiceKey(attr.(string))
+	}
+
 	properties := d.Get("property")
 	props := properties.(*schema.Set).List()
-	destination.Properties = expandNotificationDestinationProperties(props)
+	destination.Properties = notificationDestinationTypedBlocks.mergeProperties(d, expandNotificationDestinationProperties(props))
 
 	return &destination, nil
 }
@@ -144,13 +161,23 @@ func flattenNotificationDestination(destination *notifications.AiNotificationsDe
 		authAttr = "auth_token"
 	}
 
+	// The service key of the `pagerduty_service` block is sent as token credentials.
+	if _, ok := d.GetOk("pagerduty_service"); ok && authAttr == "auth_token" {
+		authAttr = ""
+	}
+
 	if authAttr != "" {
 		if err := d.Set(authAttr, auth); err != nil {
 			return fmt.Errorf("[DEBUG] Error setting notification auth: %#v", err)
 		}
 	}
 
-	if err := d.Set("property", flattenNotificationDestinationProperties(destination.Properties)); err != nil {
+	properties, err := notificationDestinationTypedBlocks.flattenProperties(d, destination.Properties)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("property", flattenNotificationDestinationProperties(withoutMonitoringProperty(properties))); err != nil {
 		return err
 	}
 
@@ -6,10 +6,12 @@ package newrelic
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/ai"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/notifications"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExpandNotificationDestination(t *testing.T) {
@@ -205,3 +207,62 @@ func testFlattenNotificationDestinationAuth(t *testing.T, v interface{}, auth ai
 		}
 	}
 }
+
+func TestExpandNotificationDestinationTypedBlock(t *testing.T) {
+	r := resourceNewRelicNotificationDestination()
+
+	d := r.TestResourceData()
+	require.NoError(t, d.Set("name", "servicenow-test"))
+	require.NoError(t, d.Set("type", "SERVICE_NOW"))
+	require.NoError(t, d.Set("servicenow", []interface{}{
+		map[string]interface{}{"url": "https://service-now.com/", "two_way_integration": true},
+	}))
+
+	expanded, err := expandNotificationDestination(d)
+	require.NoError(t, err)
+	assert.Equal(t, []notifications.AiNotificationsPropertyInput{
+		{Key: "url", Value: "https://service-now.com/"},
+		{Key: "two_way_integration", Value: "true"},
+	}, expanded.Properties)
+
+	d = r.TestResourceData()
+	require.NoError(t, d.Set("name", "pd-service-test"))
+	require.NoError(t, d.Set("type", "PAGERDUTY_SERVICE_INTEGRATION"))
+	require.NoError(t, d.Set("pagerduty_service", []interface{}{
+		map[string]interface{}{"service_key": "10567a689d984d03c021034b22a789e2"},
+	}))
+
+	expanded, err = expandNotificationDestination(d)
+	require.NoError(t, err)
+	assert.Empty(t, expanded.Properties)
+	require.NotNil(t, expanded.Auth)
+	assert.Equal(t, notifications.AiNotificationsAuthTypeTypes.TOKEN, expanded.Auth.Type)
+	assert.Equal(t, "Token token=", expanded.Auth.Token.Prefix)
+	assert.Equal(t, notifications.SecureValue("10567a689d984d03c021034b22a789e2"), expanded.Auth.Token.Token)
+}
+
+func TestFlattenNotificationDestinationTypedBlock(t *testing.T) {
+	r := resourceNewRelicNotificationDestination()
+	d := r.TestResourceData()
+
+	require.NoError(t, d.Set("jira", []interface{}{
+		map[string]interface{}{"url": "https://example.atlassian.net"},
+	}))
+
+	err := flattenNotificationDestination(&notifications.AiNotificationsDestination{
+		Name: "jira-test",
+		Type: notifications.AiNotificationsDestinationTypeTypes.JIRA,
+		Properties: []notifications.AiNotificationsProperty{
+			{Key: "url", Value: "https://example.atlassian.net"},
+			{Key: "two_way_integration", Value: "true"},
+			{Key: "source", Value: "terraform", Label: "terraform-source-internal"},
+		},
+	}, d)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.atlassian.net", d.Get("jira.0.url"))
+	assert.Equal(t, true, d.Get("jira.0.two_way_integration"))
+
+	// The property sent by the provider to mark the destinations it manages is left out
+	assert.Empty(t, d.Get("property").(*schema.Set).List())
+}
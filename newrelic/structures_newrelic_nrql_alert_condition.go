@@ -1,6 +1,7 @@
 package newrelic
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -51,6 +52,38 @@ var (
 	}
 )
 
+var nrqlConditionSignalSeasonalities = []string{
+	"NEW_RELIC_CALCULATION",
+	"HOURLY",
+	"DAILY",
+	"WEEKLY",
+	"NONE",
+}
+
+// nrqlConditionExtendedFields holds the NRQL condition settings which are not
+// modeled by the alerts package of newrelic-client-go. They are merged into the
+// condition input sent to NerdGraph and are read back with a separate query.
+type nrqlConditionExtendedFields struct {
+	TitleTemplate     *string                          `json:"titleTemplate,omitempty"`
+	SignalSeasonality *string                          `json:"signalSeasonality,omitempty"`
+	Expiration        *nrqlConditionExtendedExpiration `json:"expiration,omitempty"`
+	Terms             []nrqlConditionExtendedTerm      `json:"terms,omitempty"`
+}
+
+type nrqlConditionExtendedExpiration struct {
+	IgnoreOnExpectedTermination bool `json:"ignoreOnExpectedTermination"`
+}
+
+type nrqlConditionExtendedTerm struct {
+	Priority   alerts.NrqlConditionPriority `json:"priority,omitempty"`
+	Prediction *nrqlConditionTermPrediction `json:"prediction,omitempty"`
+}
+
+type nrqlConditionTermPrediction struct {
+	PredictBy                 int  `json:"predictBy,omitempty"`
+	PreferPredictionViolation bool `json:"preferPredictionViolation"`
+}
+
 // NerdGraph
 func expandNrqlAlertConditionCreateInput(d *schema.ResourceData) (*alerts.NrqlConditionCreateInput, error) {
 	input := alerts.NrqlConditionCreateInput{
@@ -162,6 +195,117 @@ func expandNrqlAlertConditionUpdateInput(d *schema.ResourceData) (*alerts.NrqlCo
 	return &input, nil
 }
 
+// NerdGraph
+func expandNrqlConditionExtendedFields(d *schema.ResourceData) (*nrqlConditionExtendedFields, error) {
+	conditionType := strings.ToLower(d.Get("type").(string))
+
+	fields := nrqlConditionExtendedFields{
+		Expiration: &nrqlConditionExtendedExpiration{
+			IgnoreOnExpectedTermination: d.Get("ignore_on_expected_termination").(bool),
+		},
+	}
+
+	if attr, ok := d.GetOk("title_template"); ok {
+		titleTemplate := attr.(string)
+		fields.TitleTemplate = &titleTemplate
+	}
+
+	if attr, ok := d.GetOk("signal_seasonality"); ok {
+		if conditionType != "baseline" {
+			return nil, fmt.Errorf("attribute `%s` is only supported for nrql alert conditions of type `%s`", "signal_seasonality", "baseline")
+		}
+
+		seasonality := strings.ToUpper(attr.(string))
+		fields.SignalSeasonality = &seasonality
+	}
+
+	for _, priority := range []string{"critical", "warning"} {
+		prediction, ok := d.GetOk(fmt.Sprintf("%s.0.prediction", priority))
+		if !ok {
+			continue
+		}
+
+		if conditionType != "static" {
+			return nil, fmt.Errorf("attribute `%s` is only supported for nrql alert conditions of type `%s`", "prediction", "static")
+		}
+
+		fields.Terms = append(fields.Terms, nrqlConditionExtendedTerm{
+			Priority:   alerts.NrqlConditionPriority(strings.ToUpper(priority)),
+			Prediction: expandNrqlConditionTermPrediction(prediction.([]interface{})),
+		})
+	}
+
+	return &fields, nil
+}
+
+func expandNrqlConditionTermPrediction(cfg []interface{}) *nrqlConditionTermPrediction {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	p := cfg[0].(map[string]interface{})
+
+	return &nrqlConditionTermPrediction{
+		PredictBy:                 p["predict_by"].(int),
+		PreferPredictionViolation: p["prefer_prediction_violation"].(bool),
+	}
+}
+
+// Merges the extended fields into a NerdGraph condition input (create or update),
+// returning the variables map to be sent as the `condition` argument of a mutation.
+func mergeNrqlConditionExtendedFields(input interface{}, fields *nrqlConditionExtendedFields) (map[string]interface{}, error) {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := map[string]interface{}{}
+	if err = json.Unmarshal(b, &condition); err != nil {
+		return nil, err
+	}
+
+	if fields == nil {
+		return condition, nil
+	}
+
+	// An unset title template is sent as null, which restores the default incident title.
+	if fields.TitleTemplate != nil {
+		condition["titleTemplate"] = *fields.TitleTemplate
+	} else {
+		condition["titleTemplate"] = nil
+	}
+
+	if fields.SignalSeasonality != nil {
+		condition["signalSeasonality"] = *fields.SignalSeasonality
+	}
+
+	if fields.Expiration != nil {
+		expiration, ok := condition["expiration"].(map[string]interface{})
+		if !ok {
+			expiration = map[string]interface{}{}
+		}
+
+		expiration["ignoreOnExpectedTermination"] = fields.Expiration.IgnoreOnExpectedTermination
+		condition["expiration"] = expiration
+	}
+
+	terms, _ := condition["terms"].([]interface{})
+	for _, t := range terms {
+		term, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, extendedTerm := range fields.Terms {
+			if extendedTerm.Prediction != nil && strings.EqualFold(fmt.Sprint(term["priority"]), string(extendedTerm.Priority)) {
+				term["prediction"] = extendedTerm.Prediction
+			}
+		}
+	}
+
+	return condition, nil
+}
+
 // NerdGraph
 func expandCreateNrql(d *schema.ResourceData, condition alerts.NrqlConditionCreateInput) (*alerts.NrqlConditionCreateQuery, error) {
 	var nrql alerts.NrqlConditionCreateQuery
@@ -563,6 +707,56 @@ func flattenNrqlAlertCondition(accountID int, condition *alerts.NrqlAlertConditi
 	return nil
 }
 
+// NerdGraph
+func flattenNrqlConditionExtendedFields(d *schema.ResourceData, fields *nrqlConditionExtendedFields) error {
+	if fields == nil {
+		return nil
+	}
+
+	if err := d.Set("title_template", fields.TitleTemplate); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting nrql alert condition `title_template`: %v", err)
+	}
+
+	if fields.SignalSeasonality != nil {
+		if err := d.Set("signal_seasonality", *fields.SignalSeasonality); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting nrql alert condition `signal_seasonality`: %v", err)
+		}
+	}
+
+	if fields.Expiration != nil {
+		if err := d.Set("ignore_on_expected_termination", fields.Expiration.IgnoreOnExpectedTermination); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting nrql alert condition `ignore_on_expected_termination`: %v", err)
+		}
+	}
+
+	for _, priority := range []string{"critical", "warning"} {
+		terms, ok := d.Get(priority).([]interface{})
+		if !ok || len(terms) == 0 || terms[0] == nil {
+			continue
+		}
+
+		term := terms[0].(map[string]interface{})
+		term["prediction"] = nil
+
+		for _, extendedTerm := range fields.Terms {
+			if strings.EqualFold(string(extendedTerm.Priority), priority) && extendedTerm.Prediction != nil {
+				term["prediction"] = []interface{}{
+					map[string]interface{}{
+						"predict_by":                  extendedTerm.Prediction.PredictBy,
+						"prefer_prediction_violation": extendedTerm.Prediction.PreferPredictionViolation,
+					},
+				}
+			}
+		}
+
+		if err := d.Set(priority, []interface{}{term}); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting nrql alert condition `%s`: %v", priority, err)
+		}
+	}
+
+	return nil
+}
+
 // NerdGraph
 func flattenExpiration(d *schema.ResourceData, expiration *alerts.AlertsNrqlConditionExpiration) error {
 	if expiration == nil {
@@ -0,0 +1,346 @@
+package newrelic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+)
+
+// Legacy conditions are evaluated once per minute on data which is already
+// aggregated, so the converted conditions use one-minute event flow aggregation.
+const (
+	nrqlConditionConversionAggregationWindow = 60
+	nrqlConditionConversionAggregationMethod = "event_flow"
+	nrqlConditionConversionAggregationDelay  = 120
+)
+
+// Maps the `apm_app_metric` metrics to the equivalent selection of APM metric timeslice data.
+var apmAppMetricNrqlSelects = map[string]string{
+	"apdex":                    "apdex(apm.service.apdex)",
+	"error_percentage":         "count(apm.service.error.count) / count(apm.service.transaction.duration) * 100",
+	"response_time_background": "average(apm.service.transaction.duration)",
+	"response_time_web":        "average(apm.service.transaction.duration)",
+	"throughput_background":    "rate(count(apm.service.transaction.duration), 1 minute)",
+	"throughput_web":           "rate(count(apm.service.transaction.duration), 1 minute)",
+}
+
+var apmAppMetricTransactionTypes = map[string]string{
+	"response_time_background": "Other",
+	"response_time_web":        "Web",
+	"throughput_background":    "Other",
+	"throughput_web":           "Web",
+}
+
+// Maps the `user_defined_value_function` values which have an NRQL equivalent.
+var userDefinedValueFunctionNrqlFunctions = map[string]string{
+	"average":     "average",
+	"max":         "max",
+	"min":         "min",
+	"sample_size": "count",
+	"total":       "sum",
+}
+
+var legacyConditionOperators = map[string]string{
+	"above": "above",
+	"below": "below",
+	"equal": "equals",
+}
+
+var legacyConditionTimeFunctions = map[string]string{
+	"all": "ALL",
+	"any": "AT_LEAST_ONCE",
+}
+
+// nrqlConditionConversion holds the NRQL alert condition settings equivalent to a legacy condition.
+type nrqlConditionConversion struct {
+	Name                        string
+	Enabled                     bool
+	RunbookURL                  string
+	Description                 string
+	Nrql                        string
+	Critical                    *nrqlConditionConversionTerm
+	Warning                     *nrqlConditionConversionTerm
+	FillOption                  string
+	FillValue                   float64
+	ExpirationDuration          int
+	OpenViolationOnExpiration   bool
+	CloseViolationsOnExpiration bool
+	ViolationTimeLimitSeconds   int
+}
+
+type nrqlConditionConversionTerm struct {
+	Operator             string
+	Threshold            float64
+	ThresholdDuration    int
+	ThresholdOccurrences string
+}
+
+// Copies the configurable attributes of a legacy condition resource, for use as a
+// configuration block of the conversion data source.
+func nrqlConditionConversionSourceSchema(r *schema.Resource) *schema.Resource {
+	s := map[string]*schema.Schema{}
+
+	for k, v := range r.Schema {
+		if k == "policy_id" || (v.Computed && !v.Optional) {
+			continue
+		}
+
+		attr := *v
+		attr.ForceNew = false
+		s[k] = &attr
+	}
+
+	return &schema.Resource{Schema: s}
+}
+
+// Converts an APM metric condition into the equivalent NRQL alert condition settings.
+func convertAlertConditionToNrql(condition *alerts.Condition) (*nrqlConditionConversion, error) {
+	if condition.Type != "apm_app_metric" {
+		return nil, fmt.Errorf("conversion of `%s` conditions is not supported, only `apm_app_metric` conditions can be converted", condition.Type)
+	}
+
+	if len(condition.Entities) == 0 {
+		return nil, fmt.Errorf("attribute `entities` is required to convert alert conditions")
+	}
+
+	metric := string(condition.Metric)
+	where := []string{fmt.Sprintf("appId IN (%s)", strings.Join(condition.Entities, ", "))}
+
+	var selection string
+	if metric == "user_defined" {
+		valueFunction := string(condition.UserDefined.ValueFunction)
+
+		function, ok := userDefinedValueFunctionNrqlFunctions[valueFunction]
+		if !ok {
+			return nil, fmt.Errorf("user defined value function `%s` has no NRQL equivalent", valueFunction)
+		}
+
+		if condition.UserDefined.Metric == "" {
+			return nil, fmt.Errorf("attribute `user_defined_metric` is required to convert `user_defined` alert conditions")
+		}
+
+		selection = fmt.Sprintf("%s(newrelic.timeslice.value)", function)
+		where = append(where, fmt.Sprintf("metricTimesliceName = '%s'", escapeNrqlString(condition.UserDefined.Metric)))
+	} else {
+		var ok bool
+		if selection, ok = apmAppMetricNrqlSelects[metric]; !ok {
+			return nil, fmt.Errorf("conversion of `%s` alert conditions is not supported", metric)
+		}
+
+		if transactionType, ok := apmAppMetricTransactionTypes[metric]; ok {
+			where = append(where, fmt.Sprintf("transactionType = '%s'", transactionType))
+		}
+	}
+
+	facet := "appName"
+	if condition.Scope == "instance" {
+		facet = "appName, host"
+	}
+
+	conversion := &nrqlConditionConversion{
+		Name:                      condition.Name,
+		Enabled:                   condition.Enabled,
+		RunbookURL:                condition.RunbookURL,
+		Nrql:                      fmt.Sprintf("SELECT %s FROM Metric WHERE %s FACET %s", selection, strings.Join(where, " AND "), facet),
+		FillOption:                "none",
+		ViolationTimeLimitSeconds: violationTimeLimitSecondsDefault,
+	}
+
+	if condition.ViolationCloseTimer > 0 {
+		conversion.ViolationTimeLimitSeconds = condition.ViolationCloseTimer * 3600
+	}
+
+	for _, term := range condition.Terms {
+		converted, err := convertLegacyConditionTerm(string(term.Operator), term.Threshold, term.Duration, string(term.TimeFunction))
+		if err != nil {
+			return nil, err
+		}
+
+		if term.Priority == "warning" {
+			conversion.Warning = converted
+		} else {
+			conversion.Critical = converted
+		}
+	}
+
+	return conversion, nil
+}
+
+// Converts an infrastructure condition into the equivalent NRQL alert condition settings.
+func convertInfraAlertConditionToNrql(condition *alerts.InfrastructureCondition) (*nrqlConditionConversion, error) {
+	conversion := &nrqlConditionConversion{
+		Name:                      condition.Name,
+		Enabled:                   condition.Enabled,
+		RunbookURL:                condition.RunbookURL,
+		Description:               condition.Description,
+		FillOption:                "none",
+		ViolationTimeLimitSeconds: violationTimeLimitSecondsDefault,
+	}
+
+	if condition.ViolationCloseTimer != nil && *condition.ViolationCloseTimer > 0 {
+		conversion.ViolationTimeLimitSeconds = *condition.ViolationCloseTimer * 3600
+	}
+
+	var where []string
+	if condition.Where != "" {
+		where = append(where, fmt.Sprintf("(%s)", condition.Where))
+	}
+
+	switch strings.ToLower(condition.Type) {
+	case "infra_metric":
+		if condition.Event == "" || condition.Select == "" {
+			return nil, fmt.Errorf("attributes `event` and `select` are required to convert `infra_metric` conditions")
+		}
+
+		if condition.IntegrationProvider != "" {
+			where = append(where, fmt.Sprintf("provider = '%s'", escapeNrqlString(condition.IntegrationProvider)))
+		}
+
+		conversion.Nrql = buildLegacyConditionNrql(fmt.Sprintf("average(%s)", condition.Select), condition.Event, where)
+
+	case "infra_process_running":
+		if condition.ProcessWhere != "" {
+			where = append([]string{fmt.Sprintf("(%s)", condition.ProcessWhere)}, where...)
+		}
+
+		conversion.Nrql = buildLegacyConditionNrql("uniqueCount(processId)", "ProcessSample", where)
+
+		// Hosts on which no matching process runs stop reporting the signal entirely,
+		// so a process which isn't running is detected through loss of signal.
+		if condition.Critical != nil && condition.Comparison != "above" {
+			conversion.ExpirationDuration = condition.Critical.Duration * 60
+			conversion.OpenViolationOnExpiration = true
+			conversion.CloseViolationsOnExpiration = true
+		}
+
+	case "infra_host_not_reporting":
+		if condition.Critical == nil {
+			return nil, fmt.Errorf("attribute `critical` is required to convert `infra_host_not_reporting` conditions")
+		}
+
+		conversion.Nrql = buildLegacyConditionNrql("count(*)", "SystemSample", where)
+		conversion.Critical = &nrqlConditionConversionTerm{
+			Operator:             "below",
+			Threshold:            1,
+			ThresholdDuration:    condition.Critical.Duration * 60,
+			ThresholdOccurrences: "ALL",
+		}
+		conversion.ExpirationDuration = condition.Critical.Duration * 60
+		conversion.OpenViolationOnExpiration = true
+		conversion.CloseViolationsOnExpiration = true
+
+		return conversion, nil
+
+	default:
+		return nil, fmt.Errorf("conversion of `%s` conditions is not supported", condition.Type)
+	}
+
+	var err error
+	if conversion.Critical, err = convertInfraAlertConditionThreshold(condition.Comparison, condition.Critical); err != nil {
+		return nil, err
+	}
+
+	if conversion.Warning, err = convertInfraAlertConditionThreshold(condition.Comparison, condition.Warning); err != nil {
+		return nil, err
+	}
+
+	return conversion, nil
+}
+
+func convertInfraAlertConditionThreshold(comparison string, threshold *alerts.InfrastructureConditionThreshold) (*nrqlConditionConversionTerm, error) {
+	if threshold == nil {
+		return nil, nil
+	}
+
+	var value float64
+	if threshold.Value != nil {
+		value = *threshold.Value
+	}
+
+	timeFunction := threshold.Function
+	if timeFunction == "" {
+		timeFunction = "all"
+	}
+
+	return convertLegacyConditionTerm(comparison, value, threshold.Duration, timeFunction)
+}
+
+func convertLegacyConditionTerm(operator string, threshold float64, durationMinutes int, timeFunction string) (*nrqlConditionConversionTerm, error) {
+	convertedOperator, ok := legacyConditionOperators[strings.ToLower(operator)]
+	if !ok {
+		return nil, fmt.Errorf("operator `%s` has no NRQL equivalent", operator)
+	}
+
+	occurrences, ok := legacyConditionTimeFunctions[strings.ToLower(timeFunction)]
+	if !ok {
+		return nil, fmt.Errorf("time function `%s` has no NRQL equivalent", timeFunction)
+	}
+
+	return &nrqlConditionConversionTerm{
+		Operator:             convertedOperator,
+		Threshold:            threshold,
+		ThresholdDuration:    durationMinutes * 60,
+		ThresholdOccurrences: occurrences,
+	}, nil
+}
+
+func buildLegacyConditionNrql(selection string, eventType string, where []string) string {
+	query := fmt.Sprintf("SELECT %s FROM %s", selection, eventType)
+
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	return query + " FACET entityGuid"
+}
+
+func escapeNrqlString(s string) string {
+	return strings.ReplaceAll(s, "'", "\\'")
+}
+
+func flattenNrqlConditionConversion(conversion *nrqlConditionConversion, d *schema.ResourceData) error {
+	values := map[string]interface{}{
+		"name":                           conversion.Name,
+		"enabled":                        conversion.Enabled,
+		"runbook_url":                    conversion.RunbookURL,
+		"description":                    conversion.Description,
+		"type":                           "static",
+		"nrql":                           conversion.Nrql,
+		"critical":                       flattenNrqlConditionConversionTerm(conversion.Critical),
+		"warning":                        flattenNrqlConditionConversionTerm(conversion.Warning),
+		"aggregation_window":             nrqlConditionConversionAggregationWindow,
+		"aggregation_method":             nrqlConditionConversionAggregationMethod,
+		"aggregation_delay":              fmt.Sprintf("%d", nrqlConditionConversionAggregationDelay),
+		"fill_option":                    conversion.FillOption,
+		"fill_value":                     conversion.FillValue,
+		"expiration_duration":            conversion.ExpirationDuration,
+		"open_violation_on_expiration":   conversion.OpenViolationOnExpiration,
+		"close_violations_on_expiration": conversion.CloseViolationsOnExpiration,
+		"violation_time_limit_seconds":   conversion.ViolationTimeLimitSeconds,
+	}
+
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting nrql alert condition conversion `%s`: %v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenNrqlConditionConversionTerm(term *nrqlConditionConversionTerm) []interface{} {
+	if term == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"operator":              term.Operator,
+			"threshold":             term.Threshold,
+			"threshold_duration":    term.ThresholdDuration,
+			"threshold_occurrences": term.ThresholdOccurrences,
+		},
+	}
+}
@@ -0,0 +1,215 @@
+//go:build unit
+// +build unit
+
+package newrelic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAlertConditionToNrql(t *testing.T) {
+	cases := map[string]struct {
+		Condition   alerts.Condition
+		Expected    *nrqlConditionConversion
+		ExpectedErr string
+	}{
+		"web response time": {
+			Condition: alerts.Condition{
+				Type:     "apm_app_metric",
+				Name:     "Slow web transactions",
+				Enabled:  true,
+				Entities: []string{"123", "456"},
+				Metric:   "response_time_web",
+				Scope:    "application",
+				Terms: []alerts.ConditionTerm{
+					{Duration: 5, Operator: "above", Priority: "critical", Threshold: 0.5, TimeFunction: "all"},
+					{Duration: 10, Operator: "above", Priority: "warning", Threshold: 0.3, TimeFunction: "any"},
+				},
+			},
+			Expected: &nrqlConditionConversion{
+				Name:                      "Slow web transactions",
+				Enabled:                   true,
+				Nrql:                      "SELECT average(apm.service.transaction.duration) FROM Metric WHERE appId IN (123, 456) AND transactionType = 'Web' FACET appName",
+				Critical:                  &nrqlConditionConversionTerm{Operator: "above", Threshold: 0.5, ThresholdDuration: 300, ThresholdOccurrences: "ALL"},
+				Warning:                   &nrqlConditionConversionTerm{Operator: "above", Threshold: 0.3, ThresholdDuration: 600, ThresholdOccurrences: "AT_LEAST_ONCE"},
+				FillOption:                "none",
+				ViolationTimeLimitSeconds: violationTimeLimitSecondsDefault,
+			},
+		},
+		"user defined metric of instances": {
+			Condition: alerts.Condition{
+				Type:                "apm_app_metric",
+				Entities:            []string{"123"},
+				Metric:              "user_defined",
+				Scope:               "instance",
+				ViolationCloseTimer: 4,
+				UserDefined:         alerts.ConditionUserDefined{Metric: "Custom/Queue's/Size", ValueFunction: "total"},
+				Terms: []alerts.ConditionTerm{
+					{Duration: 5, Operator: "equal", Priority: "critical", Threshold: 0, TimeFunction: "all"},
+				},
+			},
+			Expected: &nrqlConditionConversion{
+				Nrql:                      `SELECT sum(newrelic.timeslice.value) FROM Metric WHERE appId IN (123) AND metricTimesliceName = 'Custom/Queue\'s/Size' FACET appName, host`,
+				Critical:                  &nrqlConditionConversionTerm{Operator: "equals", Threshold: 0, ThresholdDuration: 300, ThresholdOccurrences: "ALL"},
+				FillOption:                "none",
+				ViolationTimeLimitSeconds: 14400,
+			},
+		},
+		"unsupported condition type": {
+			Condition:   alerts.Condition{Type: "browser_metric", Entities: []string{"123"}, Metric: "total_page_load"},
+			ExpectedErr: "conversion of `browser_metric` conditions is not supported, only `apm_app_metric` conditions can be converted",
+		},
+		"unsupported value function": {
+			Condition: alerts.Condition{
+				Type:        "apm_app_metric",
+				Entities:    []string{"123"},
+				Metric:      "user_defined",
+				UserDefined: alerts.ConditionUserDefined{Metric: "Custom/Size", ValueFunction: "percent"},
+			},
+			ExpectedErr: "user defined value function `percent` has no NRQL equivalent",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := convertAlertConditionToNrql(&tc.Condition)
+
+			if tc.ExpectedErr != "" {
+				require.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, result)
+		})
+	}
+}
+
+func TestConvertInfraAlertConditionToNrql(t *testing.T) {
+	value := 90.0
+	zero := 0.0
+	closeTimer := 2
+
+	cases := map[string]struct {
+		Condition   alerts.InfrastructureCondition
+		Expected    *nrqlConditionConversion
+		ExpectedErr string
+	}{
+		"metric": {
+			Condition: alerts.InfrastructureCondition{
+				Type:                "infra_metric",
+				Name:                "High CPU",
+				Enabled:             true,
+				Description:         "CPU is high",
+				Event:               "SystemSample",
+				Select:              "cpuPercent",
+				Comparison:          "above",
+				Where:               "hostname LIKE '%cassandra%'",
+				ViolationCloseTimer: &closeTimer,
+				Critical:            &alerts.InfrastructureConditionThreshold{Duration: 5, Function: "all", Value: &value},
+			},
+			Expected: &nrqlConditionConversion{
+				Name:                      "High CPU",
+				Enabled:                   true,
+				Description:               "CPU is high",
+				Nrql:                      "SELECT average(cpuPercent) FROM SystemSample WHERE (hostname LIKE '%cassandra%') FACET entityGuid",
+				Critical:                  &nrqlConditionConversionTerm{Operator: "above", Threshold: 90, ThresholdDuration: 300, ThresholdOccurrences: "ALL"},
+				FillOption:                "none",
+				ViolationTimeLimitSeconds: 7200,
+			},
+		},
+		"process not running": {
+			Condition: alerts.InfrastructureCondition{
+				Type:         "infra_process_running",
+				Comparison:   "equal",
+				ProcessWhere: "commandName = 'java'",
+				Critical:     &alerts.InfrastructureConditionThreshold{Duration: 10, Value: &zero},
+			},
+			Expected: &nrqlConditionConversion{
+				Nrql:                        "SELECT uniqueCount(processId) FROM ProcessSample WHERE (commandName = 'java') FACET entityGuid",
+				Critical:                    &nrqlConditionConversionTerm{Operator: "equals", Threshold: 0, ThresholdDuration: 600, ThresholdOccurrences: "ALL"},
+				FillOption:                  "none",
+				ExpirationDuration:          600,
+				OpenViolationOnExpiration:   true,
+				CloseViolationsOnExpiration: true,
+				ViolationTimeLimitSeconds:   violationTimeLimitSecondsDefault,
+			},
+		},
+		"host not reporting": {
+			Condition: alerts.InfrastructureCondition{
+				Type:     "infra_host_not_reporting",
+				Where:    "environment = 'production'",
+				Critical: &alerts.InfrastructureConditionThreshold{Duration: 15},
+			},
+			Expected: &nrqlConditionConversion{
+				Nrql:                        "SELECT count(*) FROM SystemSample WHERE (environment = 'production') FACET entityGuid",
+				Critical:                    &nrqlConditionConversionTerm{Operator: "below", Threshold: 1, ThresholdDuration: 900, ThresholdOccurrences: "ALL"},
+				FillOption:                  "none",
+				ExpirationDuration:          900,
+				OpenViolationOnExpiration:   true,
+				CloseViolationsOnExpiration: true,
+				ViolationTimeLimitSeconds:   violationTimeLimitSecondsDefault,
+			},
+		},
+		"metric without select": {
+			Condition:   alerts.InfrastructureCondition{Type: "infra_metric", Event: "SystemSample"},
+			ExpectedErr: "attributes `event` and `select` are required to convert `infra_metric` conditions",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			result, err := convertInfraAlertConditionToNrql(&tc.Condition)
+
+			if tc.ExpectedErr != "" {
+				require.EqualError(t, err, tc.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.Expected, result)
+		})
+	}
+}
+
+func TestDataSourceNewRelicNrqlAlertConditionConversionRead_Config(t *testing.T) {
+	r := dataSourceNewRelicNrqlAlertConditionConversion()
+	require.NoError(t, r.InternalValidate(nil, false))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"infra_alert_condition": []interface{}{
+			map[string]interface{}{
+				"name":       "High disk usage",
+				"type":       "infra_metric",
+				"event":      "StorageSample",
+				"select":     "diskUsedPercent",
+				"comparison": "above",
+				"critical": []interface{}{
+					map[string]interface{}{"duration": 25, "value": 90.0, "time_function": "all"},
+				},
+				"warning": []interface{}{
+					map[string]interface{}{"duration": 10, "value": 80.0, "time_function": "any"},
+				},
+			},
+		},
+	})
+
+	diags := dataSourceNewRelicNrqlAlertConditionConversionRead(context.Background(), d, &ProviderConfig{})
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "High disk usage", d.Get("name"))
+	assert.Equal(t, "static", d.Get("type"))
+	assert.Equal(t, "SELECT average(diskUsedPercent) FROM StorageSample FACET entityGuid", d.Get("nrql"))
+	assert.Equal(t, "above", d.Get("critical.0.operator"))
+	assert.Equal(t, 1500, d.Get("critical.0.threshold_duration"))
+	assert.Equal(t, "AT_LEAST_ONCE", d.Get("warning.0.threshold_occurrences"))
+	assert.Equal(t, 60, d.Get("aggregation_window"))
+	assert.Equal(t, "120", d.Get("aggregation_delay"))
+	assert.Equal(t, 86400, d.Get("violation_time_limit_seconds"))
+}
@@ -703,3 +703,233 @@ func TestExpandNrqlConditionTerm(t *testing.T) {
 	}
 
 }
+
+func TestExpandNrqlConditionExtendedFields(t *testing.T) {
+	prediction := []map[string]interface{}{
+		{
+			"predict_by":                  7200,
+			"prefer_prediction_violation": true,
+		},
+	}
+
+	criticalTerms := []map[string]interface{}{
+		{
+			"threshold":             1.0,
+			"threshold_occurrences": "all",
+			"threshold_duration":    600,
+			"operator":              "above",
+			"prediction":            prediction,
+		},
+	}
+
+	cases := map[string]struct {
+		Data         map[string]interface{}
+		ExpectErr    bool
+		ExpectReason string
+		Expanded     *nrqlConditionExtendedFields
+	}{
+		"defaults": {
+			Data: map[string]interface{}{
+				"type": "static",
+			},
+			Expanded: &nrqlConditionExtendedFields{
+				Expiration: &nrqlConditionExtendedExpiration{},
+			},
+		},
+		"ignore on expected termination": {
+			Data: map[string]interface{}{
+				"type":                           "static",
+				"ignore_on_expected_termination": true,
+			},
+			Expanded: &nrqlConditionExtendedFields{
+				Expiration: &nrqlConditionExtendedExpiration{
+					IgnoreOnExpectedTermination: true,
+				},
+			},
+		},
+		"title template": {
+			Data: map[string]interface{}{
+				"type":           "static",
+				"title_template": "{{conditionName}} on {{tags.host}}",
+			},
+			Expanded: &nrqlConditionExtendedFields{
+				TitleTemplate: &[]string{"{{conditionName}} on {{tags.host}}"}[0],
+				Expiration:    &nrqlConditionExtendedExpiration{},
+			},
+		},
+		"baseline condition, signal seasonality": {
+			Data: map[string]interface{}{
+				"type":               "baseline",
+				"signal_seasonality": "weekly",
+			},
+			Expanded: &nrqlConditionExtendedFields{
+				SignalSeasonality: &[]string{"WEEKLY"}[0],
+				Expiration:        &nrqlConditionExtendedExpiration{},
+			},
+		},
+		"static condition, signal seasonality": {
+			Data: map[string]interface{}{
+				"type":               "static",
+				"signal_seasonality": "weekly",
+			},
+			ExpectErr:    true,
+			ExpectReason: "attribute `signal_seasonality` is only supported for nrql alert conditions of type `baseline`",
+		},
+		"static condition, critical prediction": {
+			Data: map[string]interface{}{
+				"type":     "static",
+				"critical": criticalTerms,
+			},
+			Expanded: &nrqlConditionExtendedFields{
+				Expiration: &nrqlConditionExtendedExpiration{},
+				Terms: []nrqlConditionExtendedTerm{
+					{
+						Priority: alerts.NrqlConditionPriorities.Critical,
+						Prediction: &nrqlConditionTermPrediction{
+							PredictBy:                 7200,
+							PreferPredictionViolation: true,
+						},
+					},
+				},
+			},
+		},
+		"baseline condition, critical prediction": {
+			Data: map[string]interface{}{
+				"type":     "baseline",
+				"critical": criticalTerms,
+			},
+			ExpectErr:    true,
+			ExpectReason: "attribute `prediction` is only supported for nrql alert conditions of type `static`",
+		},
+	}
+
+	r := resourceNewRelicNrqlAlertCondition()
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := r.TestResourceData()
+
+			for k, v := range tc.Data {
+				if err := d.Set(k, v); err != nil {
+					t.Fatalf("err: %s", err)
+				}
+			}
+
+			expanded, err := expandNrqlConditionExtendedFields(d)
+
+			if tc.ExpectErr {
+				require.NotNil(t, err)
+				require.Equal(t, tc.ExpectReason, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.Expanded, expanded)
+		})
+	}
+}
+
+func TestMergeNrqlConditionExtendedFields(t *testing.T) {
+	input := alerts.NrqlConditionCreateInput{}
+	input.Name = "name-test"
+	input.Terms = []alerts.NrqlConditionTerm{
+		{
+			Threshold:            &testThresholdLow,
+			ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+			ThresholdDuration:    600,
+			Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+			Priority:             alerts.NrqlConditionPriorities.Critical,
+		},
+		{
+			Threshold:            &testThresholdHigh,
+			ThresholdOccurrences: alerts.ThresholdOccurrences.AtLeastOnce,
+			ThresholdDuration:    660,
+			Operator:             alerts.AlertsNRQLConditionTermsOperatorTypes.ABOVE,
+			Priority:             alerts.NrqlConditionPriorities.Warning,
+		},
+	}
+	input.Expiration = &alerts.AlertsNrqlConditionExpiration{
+		ExpirationDuration:        &[]int{120}[0],
+		OpenViolationOnExpiration: true,
+	}
+
+	prediction := &nrqlConditionTermPrediction{
+		PredictBy: 3600,
+	}
+
+	merged, err := mergeNrqlConditionExtendedFields(&input, &nrqlConditionExtendedFields{
+		SignalSeasonality: &[]string{"DAILY"}[0],
+		Expiration: &nrqlConditionExtendedExpiration{
+			IgnoreOnExpectedTermination: true,
+		},
+		Terms: []nrqlConditionExtendedTerm{
+			{
+				Priority:   alerts.NrqlConditionPriorities.Warning,
+				Prediction: prediction,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "name-test", merged["name"])
+	require.Equal(t, "DAILY", merged["signalSeasonality"])
+
+	// An unset title template is explicitly sent as null
+	titleTemplate, ok := merged["titleTemplate"]
+	require.True(t, ok)
+	require.Nil(t, titleTemplate)
+
+	expiration := merged["expiration"].(map[string]interface{})
+	require.Equal(t, true, expiration["ignoreOnExpectedTermination"])
+	require.Equal(t, true, expiration["openViolationOnExpiration"])
+	require.Equal(t, float64(120), expiration["expirationDuration"])
+
+	terms := merged["terms"].([]interface{})
+	require.Len(t, terms, 2)
+	require.NotContains(t, terms[0].(map[string]interface{}), "prediction")
+	require.Equal(t, prediction, terms[1].(map[string]interface{})["prediction"])
+}
+
+func TestFlattenNrqlConditionExtendedFields(t *testing.T) {
+	r := resourceNewRelicNrqlAlertCondition()
+	d := r.TestResourceData()
+
+	err := d.Set("critical", []map[string]interface{}{
+		{
+			"threshold":             1.0,
+			"threshold_occurrences": "all",
+			"threshold_duration":    600,
+			"operator":              "above",
+		},
+	})
+	require.NoError(t, err)
+
+	err = flattenNrqlConditionExtendedFields(d, &nrqlConditionExtendedFields{
+		TitleTemplate:     &[]string{"{{conditionName}}"}[0],
+		SignalSeasonality: &[]string{"HOURLY"}[0],
+		Expiration: &nrqlConditionExtendedExpiration{
+			IgnoreOnExpectedTermination: true,
+		},
+		Terms: []nrqlConditionExtendedTerm{
+			{
+				Priority: alerts.NrqlConditionPriorities.Critical,
+				Prediction: &nrqlConditionTermPrediction{
+					PredictBy:                 1800,
+					PreferPredictionViolation: true,
+				},
+			},
+			{
+				Priority: alerts.NrqlConditionPriorities.Warning,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "{{conditionName}}", d.Get("title_template").(string))
+	require.Equal(t, "HOURLY", d.Get("signal_seasonality").(string))
+	require.True(t, d.Get("ignore_on_expected_termination").(bool))
+	require.Equal(t, 600, d.Get("critical.0.threshold_duration").(int))
+	require.Equal(t, 1800, d.Get("critical.0.prediction.0.predict_by").(int))
+	require.True(t, d.Get("critical.0.prediction.0.prefer_prediction_violation").(bool))
+	require.Empty(t, d.Get("warning").([]interface{}))
+}
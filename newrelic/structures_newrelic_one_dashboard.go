@@ -15,6 +15,52 @@ import (
 	"github.com/newrelic/newrelic-client-go/v2/pkg/nrdb"
 )
 
+var dashboardWidgetThresholdSeverities = []string{"success", "warning", "severe", "critical", "unavailable"}
+
+var dashboardWidgetTooltipModes = []string{"all", "single", "hidden"}
+
+// The widget attributes event feeds have no use for.
+var dashboardWidgetEventFeedIgnoredAttributes = []string{"facet_show_other_series", "legend_enabled", "y_axis_left_min", "y_axis_left_max", "null_values", "units", "colors"}
+
+// dashboardWidgetChartConfiguration is the raw configuration of line, area, scatter and table widgets,
+// with the chart options the client doesn't know about yet. The thresholds of line and area
+// charts are an object while those of tables and billboards are a list, they are kept raw and
+// read according to the visualization.
+type dashboardWidgetChartConfiguration struct {
+	*dashboards.RawConfiguration
+	Thresholds json.RawMessage            `json:"thresholds,omitempty"`
+	YAxisRight *dashboardWidgetYAxisRight `json:"yAxisRight,omitempty"`
+	Tooltip    *dashboardWidgetTooltip    `json:"tooltip,omitempty"`
+}
+
+type dashboardWidgetLineThresholds struct {
+	IsLabelVisible *bool                      `json:"isLabelVisible,omitempty"`
+	Thresholds     []dashboardWidgetThreshold `json:"thresholds"`
+}
+
+type dashboardWidgetThreshold struct {
+	From       *float64 `json:"from,omitempty"`
+	To         *float64 `json:"to,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	ColumnName string   `json:"columnName,omitempty"`
+	Severity   string   `json:"severity"`
+}
+
+type dashboardWidgetYAxisRight struct {
+	Zero   *bool                             `json:"zero,omitempty"`
+	Min    *float64                          `json:"min,omitempty"`
+	Max    *float64                          `json:"max,omitempty"`
+	Series []dashboardWidgetYAxisRightSeries `json:"series,omitempty"`
+}
+
+type dashboardWidgetYAxisRightSeries struct {
+	Name string `json:"name"`
+}
+
+type dashboardWidgetTooltip struct {
+	Mode string `json:"mode"`
+}
+
 // Assemble the *dashboards.DashboardInput struct.
 // Used by the newrelic_one_dashboard Create function.
 func expandDashboardInput(d *schema.ResourceData, meta interface{}) (*dashboards.DashboardInput, error) {
@@ -179,7 +225,12 @@ func expandDashboardPageInput(d *schema.ResourceData, pages []interface{}, meta
 					return nil, err
 				}
 
-				widget.RawConfiguration, err = json.Marshal(rawConfiguration)
+				chartConfiguration, err := expandDashboardWidgetChartConfiguration(v.(map[string]interface{}), rawConfiguration, "viz.area")
+				if err != nil {
+					return nil, err
+				}
+
+				widget.RawConfiguration, err = json.Marshal(chartConfiguration)
 				if err != nil {
 					return nil, err
 				}
@@ -294,7 +345,12 @@ func expandDashboardPageInput(d *schema.ResourceData, pages []interface{}, meta
 					return nil, err
 				}
 
-				widget.RawConfiguration, err = json.Marshal(rawConfiguration)
+				chartConfiguration, err := expandDashboardWidgetChartConfiguration(v.(map[string]interface{}), rawConfiguration, "viz.line")
+				if err != nil {
+					return nil, err
+				}
+
+				widget.RawConfiguration, err = json.Marshal(chartConfiguration)
 				if err != nil {
 					return nil, err
 				}
@@ -342,7 +398,12 @@ func expandDashboardPageInput(d *schema.ResourceData, pages []interface{}, meta
 					return nil, err
 				}
 
-				widget.RawConfiguration, err = json.Marshal(rawConfiguration)
+				chartConfiguration, err := expandDashboardWidgetChartConfiguration(v.(map[string]interface{}), rawConfiguration, "viz.table")
+				if err != nil {
+					return nil, err
+				}
+
+				widget.RawConfiguration, err = json.Marshal(chartConfiguration)
 				if err != nil {
 					return nil, err
 				}
@@ -398,6 +459,55 @@ func expandDashboardPageInput(d *schema.ResourceData, pages []interface{}, meta
 				page.Widgets = append(page.Widgets, *widget)
 			}
 		}
+		if widgets, ok := p["widget_event_feed"]; ok {
+			for _, v := range widgets.([]interface{}) {
+				// Get generic properties set
+				widget, rawConfiguration, err := expandDashboardWidgetInput(v.(map[string]interface{}), meta, "viz.event-feed")
+				if err != nil {
+					return nil, err
+				}
+
+				widget.RawConfiguration, err = json.Marshal(rawConfiguration)
+				if err != nil {
+					return nil, err
+				}
+
+				page.Widgets = append(page.Widgets, *widget)
+			}
+		}
+		if widgets, ok := p["widget_scatter"]; ok {
+			for _, v := range widgets.([]interface{}) {
+				// Get generic properties set
+				widget, rawConfiguration, err := expandDashboardWidgetInput(v.(map[string]interface{}), meta, "viz.scatter")
+				if err != nil {
+					return nil, err
+				}
+
+				chartConfiguration, err := expandDashboardWidgetChartConfiguration(v.(map[string]interface{}), rawConfiguration, "viz.scatter")
+				if err != nil {
+					return nil, err
+				}
+
+				widget.RawConfiguration, err = json.Marshal(chartConfiguration)
+				if err != nil {
+					return nil, err
+				}
+
+				page.Widgets = append(page.Widgets, *widget)
+			}
+		}
+
+		if autoLayout, ok := p["auto_layout"]; ok && autoLayout.(bool) {
+			keys := []string{}
+			pageKeys := dashboardWidgetPlacementKeys(pageIndex, p)
+			for _, widgetType := range dashboardWidgetTypes {
+				keys = append(keys, pageKeys[widgetType]...)
+			}
+
+			if err := placeDashboardPageWidgets(page.Widgets, keys, dashboardStoredWidgetPlacements(d)); err != nil {
+				return nil, fmt.Errorf("page %q: %s", page.Name, err)
+			}
+		}
 
 		expanded[pageIndex] = page
 	}
@@ -491,7 +601,7 @@ func expandDashboardWidgetInput(w map[string]interface{}, meta interface{}, visu
 		cfg.Facet = &l
 	}
 
-	if visualisation != "viz.line" {
+	if visualisation != "viz.line" && visualisation != "viz.scatter" {
 		if q, ok := w["y_axis_left_min"]; ok {
 			var l dashboards.DashboardWidgetYAxisLeft
 			min := q.(float64)
@@ -531,6 +641,101 @@ func expandDashboardWidgetInput(w map[string]interface{}, meta interface{}, visu
 	return &widget, &cfg, nil
 }
 
+// expandDashboardWidgetChartConfiguration adds the thresholds, right Y axis and tooltip of line,
+// area and table widgets to their raw configuration.
+func expandDashboardWidgetChartConfiguration(w map[string]interface{}, cfg *dashboards.RawConfiguration, visualisation string) (*dashboardWidgetChartConfiguration, error) {
+	chartCfg := dashboardWidgetChartConfiguration{RawConfiguration: cfg}
+
+	if t, ok := w["threshold"]; ok && len(t.([]interface{})) > 0 {
+		var thresholds interface{}
+		items := expandDashboardWidgetThresholds(t.([]interface{}))
+
+		if visualisation == "viz.table" {
+			thresholds = items
+		} else {
+			lineThresholds := dashboardWidgetLineThresholds{Thresholds: items}
+			if v, ok := w["is_label_visible"]; ok {
+				visible := v.(bool)
+				lineThresholds.IsLabelVisible = &visible
+			}
+			thresholds = lineThresholds
+		}
+
+		raw, err := json.Marshal(thresholds)
+		if err != nil {
+			return nil, err
+		}
+		chartCfg.Thresholds = raw
+	}
+
+	if y, ok := w["y_axis_right"]; ok && len(y.([]interface{})) > 0 && y.([]interface{})[0] != nil {
+		axisCfg := y.([]interface{})[0].(map[string]interface{})
+		var axis dashboardWidgetYAxisRight
+
+		if v, ok := axisCfg["zero"]; ok {
+			zero := v.(bool)
+			axis.Zero = &zero
+		}
+		axis.Min = expandDashboardWidgetFloatString(axisCfg["min"])
+		axis.Max = expandDashboardWidgetFloatString(axisCfg["max"])
+		if v, ok := axisCfg["series"]; ok {
+			for _, name := range v.([]interface{}) {
+				axis.Series = append(axis.Series, dashboardWidgetYAxisRightSeries{Name: name.(string)})
+			}
+		}
+
+		chartCfg.YAxisRight = &axis
+	}
+
+	if m, ok := w["tooltip_mode"]; ok && m.(string) != "" {
+		chartCfg.Tooltip = &dashboardWidgetTooltip{Mode: m.(string)}
+	}
+
+	return &chartCfg, nil
+}
+
+func expandDashboardWidgetThresholds(in []interface{}) []dashboardWidgetThreshold {
+	thresholds := []dashboardWidgetThreshold{}
+
+	for _, v := range in {
+		if v == nil {
+			continue
+		}
+		t := v.(map[string]interface{})
+
+		threshold := dashboardWidgetThreshold{
+			From:     expandDashboardWidgetFloatString(t["from"]),
+			To:       expandDashboardWidgetFloatString(t["to"]),
+			Severity: t["severity"].(string),
+		}
+		if name, ok := t["name"]; ok {
+			threshold.Name = name.(string)
+		}
+		if columnName, ok := t["column_name"]; ok {
+			threshold.ColumnName = columnName.(string)
+		}
+
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds
+}
+
+// Numbers of widgets that may be zero are strings, left out of the configuration when empty.
+func expandDashboardWidgetFloatString(v interface{}) *float64 {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &f
+}
+
 func expandDashboardWidgetYAxisLeft(w map[string]interface{}) dashboards.DashboardWidgetYAxisLeft {
 	var l dashboards.DashboardWidgetYAxisLeft
 	if q, ok := w["y_axis_left_zero"]; ok {
@@ -724,6 +929,7 @@ func flattenDashboardEntity(dashboard *entities.DashboardEntity, d *schema.Resou
 
 	if dashboard.Pages != nil && len(dashboard.Pages) > 0 {
 		pages := flattenDashboardPage(&dashboard.Pages)
+		flattenDashboardPageAutoLayout(pages, d)
 		if err := d.Set("page", pages); err != nil {
 			return err
 		}
@@ -761,6 +967,7 @@ func flattenDashboardUpdateResult(result *dashboards.DashboardUpdateResult, d *s
 
 	if dashboard.Pages != nil && len(dashboard.Pages) > 0 {
 		pages := flattenDashboardPage(&dashboard.Pages)
+		flattenDashboardPageAutoLayout(pages, d)
 		if err := d.Set("page", pages); err != nil {
 			return err
 		}
@@ -867,6 +1074,56 @@ func flattenDashboardPage(in *[]entities.DashboardPage) []interface{} {
 	return out
 }
 
+// auto_layout is not part of the dashboard, the value from the configuration is kept. The widgets
+// it placed keep no row and column as long as they are at the position stored for them in
+// widget_placements, a widget moved outside of Terraform shows its row and column in the plan.
+func flattenDashboardPageAutoLayout(pages []interface{}, d *schema.ResourceData) {
+	placements := map[string]interface{}{}
+	for k, v := range d.Get("widget_placements").(map[string]interface{}) {
+		placements[k] = v
+	}
+
+	for i, p := range pages {
+		autoLayout, ok := d.GetOk(fmt.Sprintf("page.%d.auto_layout", i))
+		if !ok {
+			continue
+		}
+
+		page := p.(map[string]interface{})
+		page["auto_layout"] = autoLayout.(bool)
+		keys := dashboardWidgetPlacementKeys(i, page)
+
+		for _, widgetType := range dashboardWidgetTypes {
+			widgets, _ := page[widgetType].([]interface{})
+			for j, w := range widgets {
+				widget := w.(map[string]interface{})
+				placement := formatDashboardWidgetPlacement(widget["row"].(int), widget["column"].(int))
+
+				if stored, ok := placements[keys[widgetType][j]]; ok {
+					if stored != placement {
+						continue
+					}
+				} else {
+					key := fmt.Sprintf("page.%d.%s.%d", i, widgetType, j)
+					if _, ok := d.GetOk(key + ".row"); ok {
+						continue
+					}
+					if _, ok := d.GetOk(key + ".title"); !ok {
+						continue
+					}
+
+					placements[keys[widgetType][j]] = placement
+				}
+
+				widget["row"] = 0
+				widget["column"] = 0
+			}
+		}
+	}
+
+	_ = d.Set("widget_placements", placements)
+}
+
 func flattenLinkedEntityGUIDs(linkedEntities []entities.EntityOutlineInterface) []string {
 	out := make([]string, len(linkedEntities))
 
@@ -904,8 +1161,9 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 
 	// Read out the rawConfiguration field for use in all widgets
 	rawCfg := dashboards.RawConfiguration{}
+	chartCfg := dashboardWidgetChartConfiguration{RawConfiguration: &rawCfg}
 	if len(in.RawConfiguration) > 0 {
-		if err := json.Unmarshal(in.RawConfiguration, &rawCfg); err != nil {
+		if err := json.Unmarshal(in.RawConfiguration, &chartCfg); err != nil {
 			log.Printf("Error parsing: %s", err)
 		}
 	}
@@ -940,6 +1198,7 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 	case "viz.area":
 		widgetType = "widget_area"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+		flattenDashboardWidgetChartConfiguration(&chartCfg, in.Visualization.ID, out)
 	case "viz.bar":
 		widgetType = "widget_bar"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
@@ -947,6 +1206,12 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 	case "viz.billboard":
 		widgetType = "widget_billboard"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+		// The thresholds of billboards are read as those of the other charts, they are a list
+		if len(chartCfg.Thresholds) > 0 {
+			if err := json.Unmarshal(chartCfg.Thresholds, &rawCfg.Thresholds); err != nil {
+				log.Printf("Error parsing thresholds: %s", err)
+			}
+		}
 		if len(rawCfg.Thresholds) > 0 {
 			for _, v := range rawCfg.Thresholds {
 				// Double check if we have a value, the API sometimes returns a null
@@ -966,6 +1231,12 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 		widgetType = "widget_bullet"
 		out["limit"] = rawCfg.Limit
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+	case "viz.event-feed":
+		widgetType = "widget_event_feed"
+		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+		for _, k := range dashboardWidgetEventFeedIgnoredAttributes {
+			delete(out, k)
+		}
 	case "viz.funnel":
 		widgetType = "widget_funnel"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
@@ -984,6 +1255,7 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 		if rawCfg.YAxisLeft != nil {
 			out["y_axis_left_zero"] = rawCfg.YAxisLeft.Zero
 		}
+		flattenDashboardWidgetChartConfiguration(&chartCfg, in.Visualization.ID, out)
 	case "viz.markdown":
 		widgetType = "widget_markdown"
 		out["text"] = rawCfg.Text
@@ -994,10 +1266,20 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 		widgetType = "widget_pie"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
 		out["filter_current_dashboard"] = filterCurrentDashboard
+	case "viz.scatter":
+		widgetType = "widget_scatter"
+		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
+		out["filter_current_dashboard"] = filterCurrentDashboard
+		if rawCfg.YAxisLeft != nil {
+			out["y_axis_left_zero"] = rawCfg.YAxisLeft.Zero
+		}
+		delete(out, "null_values")
+		flattenDashboardWidgetChartConfiguration(&chartCfg, in.Visualization.ID, out)
 	case "viz.table":
 		widgetType = "widget_table"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
 		out["filter_current_dashboard"] = filterCurrentDashboard
+		flattenDashboardWidgetChartConfiguration(&chartCfg, in.Visualization.ID, out)
 	case "logger.log-table-widget":
 		widgetType = "widget_log_table"
 		out["nrql_query"] = flattenDashboardWidgetNRQLQuery(&rawCfg.NRQLQueries)
@@ -1006,6 +1288,82 @@ func flattenDashboardWidget(in *entities.DashboardWidget, pageGUID string) (stri
 	return widgetType, out
 }
 
+// flattenDashboardWidgetChartConfiguration reads the thresholds, right Y axis and tooltip of line,
+// area and table widgets.
+func flattenDashboardWidgetChartConfiguration(in *dashboardWidgetChartConfiguration, visualisation string, out map[string]interface{}) {
+	if len(in.Thresholds) > 0 {
+		thresholds := []dashboardWidgetThreshold{}
+
+		if visualisation == "viz.table" {
+			if err := json.Unmarshal(in.Thresholds, &thresholds); err != nil {
+				log.Printf("Error parsing thresholds: %s", err)
+			}
+		} else {
+			var lineThresholds dashboardWidgetLineThresholds
+			if err := json.Unmarshal(in.Thresholds, &lineThresholds); err != nil {
+				log.Printf("Error parsing thresholds: %s", err)
+			}
+			thresholds = lineThresholds.Thresholds
+			if lineThresholds.IsLabelVisible != nil {
+				out["is_label_visible"] = *lineThresholds.IsLabelVisible
+			}
+		}
+
+		if len(thresholds) > 0 {
+			out["threshold"] = flattenDashboardWidgetThresholds(thresholds, visualisation)
+		}
+	}
+
+	if in.YAxisRight != nil {
+		axis := map[string]interface{}{}
+		if in.YAxisRight.Zero != nil {
+			axis["zero"] = *in.YAxisRight.Zero
+		}
+		if in.YAxisRight.Min != nil {
+			axis["min"] = strconv.FormatFloat(*in.YAxisRight.Min, 'f', -1, 64)
+		}
+		if in.YAxisRight.Max != nil {
+			axis["max"] = strconv.FormatFloat(*in.YAxisRight.Max, 'f', -1, 64)
+		}
+		series := []string{}
+		for _, s := range in.YAxisRight.Series {
+			series = append(series, s.Name)
+		}
+		axis["series"] = series
+
+		out["y_axis_right"] = []interface{}{axis}
+	}
+
+	if in.Tooltip != nil {
+		out["tooltip_mode"] = in.Tooltip.Mode
+	}
+}
+
+func flattenDashboardWidgetThresholds(in []dashboardWidgetThreshold, visualisation string) []interface{} {
+	out := make([]interface{}, len(in))
+
+	for i, t := range in {
+		m := map[string]interface{}{
+			"severity": t.Severity,
+		}
+		if t.From != nil {
+			m["from"] = strconv.FormatFloat(*t.From, 'f', -1, 64)
+		}
+		if t.To != nil {
+			m["to"] = strconv.FormatFloat(*t.To, 'f', -1, 64)
+		}
+		if visualisation == "viz.table" {
+			m["column_name"] = t.ColumnName
+		} else {
+			m["name"] = t.Name
+		}
+
+		out[i] = m
+	}
+
+	return out
+}
+
 func flattenDashboardWidgetNRQLQuery(in *[]dashboards.DashboardWidgetNRQLQueryInput) []interface{} {
 	out := make([]interface{}, len(*in))
 
@@ -1021,19 +1379,19 @@ func flattenDashboardWidgetNRQLQuery(in *[]dashboards.DashboardWidgetNRQLQueryIn
 	return out
 }
 
-// Function to find all of the widgets that have filter_current_dashboard set and return the title and layout location to identify later.
+// Function to find all of the widgets that have filter_current_dashboard set and return their page, title and position, or type and index, to identify later.
 func findDashboardWidgetFilterCurrentDashboard(d *schema.ResourceData) ([]interface{}, error) {
 	var widgetList []interface{}
 
 	pages := d.Get("page").([]interface{})
-	selfLinkingWidgets := []string{"widget_bar", "widget_pie", "widget_table"}
+	selfLinkingWidgets := []string{"widget_bar", "widget_pie", "widget_scatter", "widget_table"}
 
 	for i, v := range pages {
 		p := v.(map[string]interface{})
 		// For each of the widget type, we need to expand them as well
 		for _, widgetType := range selfLinkingWidgets {
 			if widgets, ok := p[widgetType]; ok {
-				for _, widget := range widgets.([]interface{}) {
+				for j, widget := range widgets.([]interface{}) {
 					w := widget.(map[string]interface{})
 					if v, ok := w["filter_current_dashboard"]; ok && v.(bool) {
 
@@ -1049,18 +1407,18 @@ func findDashboardWidgetFilterCurrentDashboard(d *schema.ResourceData) ([]interf
 							}
 						}
 
+						// Widgets are identified by their title and position, widgets placed by
+						// auto_layout have no position and are identified by their type and index
 						unqWidget := make(map[string]interface{})
-						if t, ok := w["title"]; ok {
-							unqWidget["title"] = t.(string)
-						}
-						if r, ok := w["row"]; ok {
-							unqWidget["row"] = r.(int)
-						}
-						if c, ok := w["column"]; ok {
-							unqWidget["column"] = c.(int)
-						}
-
 						unqWidget["page"] = i
+						if r, c := w["row"].(int), w["column"].(int); r > 0 && c > 0 {
+							unqWidget["title"] = w["title"]
+							unqWidget["row"] = r
+							unqWidget["column"] = c
+						} else {
+							unqWidget["type"] = widgetType
+							unqWidget["index"] = j
+						}
 
 						widgetList = append(widgetList, unqWidget)
 					}
@@ -1076,14 +1434,14 @@ func findDashboardWidgetFilterCurrentDashboard(d *schema.ResourceData) ([]interf
 
 // Function to set the page guid as the linked entity now that the page is created
 func setDashboardWidgetFilterCurrentDashboardLinkedEntity(d *schema.ResourceData, filterWidgets []interface{}) error {
-	selfLinkingWidgets := []string{"widget_bar", "widget_pie", "widget_table"}
+	selfLinkingWidgets := []string{"widget_bar", "widget_pie", "widget_scatter", "widget_table"}
 
 	pages := d.Get("page").([]interface{})
 	for i, v := range pages {
 		p := v.(map[string]interface{})
 		for _, widgetType := range selfLinkingWidgets {
 			if widgets, ok := p[widgetType]; ok {
-				for _, k := range widgets.([]interface{}) {
+				for j, k := range widgets.([]interface{}) {
 					w := k.(map[string]interface{})
 					if l, ok := w["linked_entity_guids"]; ok && len(l.([]interface{})) == 1 {
 						for _, le := range l.([]interface{}) {
@@ -1094,14 +1452,12 @@ func setDashboardWidgetFilterCurrentDashboardLinkedEntity(d *schema.ResourceData
 					}
 					for _, f := range filterWidgets {
 						e := f.(map[string]interface{})
-						if e["page"] == i {
-							if w["title"] == e["title"] && w["column"] == e["column"] && w["row"] == e["row"] {
-								guid := p["guid"].(string)
-								if guid == "" {
-									w["linked_entity_guids"] = nil
-								} else {
-									w["linked_entity_guids"] = []string{guid}
-								}
+						if dashboardFilterWidgetMatches(e, i, widgetType, j, w) {
+							guid := p["guid"].(string)
+							if guid == "" {
+								w["linked_entity_guids"] = nil
+							} else {
+								w["linked_entity_guids"] = []string{guid}
 							}
 						}
 					}
@@ -1116,6 +1472,20 @@ func setDashboardWidgetFilterCurrentDashboardLinkedEntity(d *schema.ResourceData
 
 	return nil
 }
+
+// Whether a widget is one of those found by findDashboardWidgetFilterCurrentDashboard.
+func dashboardFilterWidgetMatches(e map[string]interface{}, page int, widgetType string, index int, w map[string]interface{}) bool {
+	if e["page"] != page {
+		return false
+	}
+
+	if _, ok := e["row"]; ok {
+		return w["title"] == e["title"] && w["column"] == e["column"] && w["row"] == e["row"]
+	}
+
+	return e["type"] == widgetType && e["index"] == index
+}
+
 func flattenDashboardWidgetNullValues(in *dashboards.DashboardWidgetNullValues) interface{} {
 	out := make([]interface{}, 1)
 	k := make(map[string]interface{})
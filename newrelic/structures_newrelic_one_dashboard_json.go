@@ -2,6 +2,9 @@ package newrelic
 
 import (
 	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -11,8 +14,25 @@ import (
 // Assemble the *dashboards.DashboardInput struct.
 // Used by the newrelic_one_dashboard Create function.
 func expandDashboardJSONInput(d *schema.ResourceData, meta interface{}) (*dashboards.DashboardInput, error) {
+	raw := d.Get("json").(string)
+
+	// Documents built from values unknown at plan time are only validated here
+	warnings, errs := validateDashboardJSON(raw)
+	for _, w := range warnings {
+		log.Printf("[WARN] Dashboard JSON: %s", w)
+	}
+
+	if len(errs) > 0 {
+		messages := make([]string, len(errs))
+		for i, e := range errs {
+			messages[i] = e.Error()
+		}
+
+		return nil, fmt.Errorf("invalid dashboard JSON:\n%s", strings.Join(messages, "\n"))
+	}
+
 	dash := dashboards.DashboardInput{}
-	err := json.Unmarshal([]byte(d.Get("json").(string)), &dash)
+	err := json.Unmarshal([]byte(raw), &dash)
 	if err != nil {
 		return nil, err
 	}
@@ -1,8 +1,11 @@
 package newrelic
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/newrelic/newrelic-client-go/v2/pkg/dashboards"
 	"github.com/newrelic/newrelic-client-go/v2/pkg/entities"
 	"github.com/stretchr/testify/assert"
 )
@@ -89,3 +92,222 @@ func TestExpandDashboardBillboardThresholdNullValue(t *testing.T) {
 	assert.NotContains(t, out, "warning")
 	assert.Equal(t, out["critical"], "2")
 }
+
+func TestFlattenDashboardLineChartOptions(t *testing.T) {
+	dashboard := entities.DashboardWidget{
+		ID: "abcde",
+		Visualization: entities.DashboardWidgetVisualization{
+			ID: "viz.line",
+		},
+		RawConfiguration: []byte(`
+		{
+			"nrqlQueries": [
+				{
+					"accountId": 1606862,
+					"query": "FROM Transaction SELECT average(duration), count(*) TIMESERIES"
+				}
+			],
+			"thresholds": {
+				"isLabelVisible": true,
+				"thresholds": [
+					{
+						"from": 1,
+						"to": 2,
+						"name": "Slow",
+						"severity": "warning"
+					}
+				]
+			},
+			"yAxisRight": {
+				"zero": true,
+				"series": [
+					{
+						"name": "Count"
+					}
+				]
+			},
+			"tooltip": {
+				"mode": "single"
+			}
+		}
+		`),
+	}
+	widgetType, out := flattenDashboardWidget(&dashboard, "abcde")
+	assert.Equal(t, "widget_line", widgetType)
+	assert.Equal(t, true, out["is_label_visible"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"from": "1", "to": "2", "name": "Slow", "severity": "warning"}}, out["threshold"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"zero": true, "series": []string{"Count"}}}, out["y_axis_right"])
+	assert.Equal(t, "single", out["tooltip_mode"])
+}
+
+func TestFlattenDashboardTableThresholds(t *testing.T) {
+	dashboard := entities.DashboardWidget{
+		ID: "abcde",
+		Visualization: entities.DashboardWidgetVisualization{
+			ID: "viz.table",
+		},
+		RawConfiguration: []byte(`
+		{
+			"nrqlQueries": [
+				{
+					"accountId": 1606862,
+					"query": "FROM Transaction SELECT count(*) FACET appName"
+				}
+			],
+			"thresholds": [
+				{
+					"columnName": "count",
+					"from": 0,
+					"to": 10,
+					"severity": "critical"
+				}
+			]
+		}
+		`),
+	}
+	widgetType, out := flattenDashboardWidget(&dashboard, "abcde")
+	assert.Equal(t, "widget_table", widgetType)
+	assert.NotContains(t, out, "is_label_visible")
+	assert.Equal(t, []interface{}{map[string]interface{}{"from": "0", "to": "10", "column_name": "count", "severity": "critical"}}, out["threshold"])
+}
+
+func TestExpandDashboardLineChartOptions(t *testing.T) {
+	w := map[string]interface{}{
+		"threshold": []interface{}{
+			map[string]interface{}{"from": "1", "to": "2", "name": "Slow", "severity": "warning"},
+			map[string]interface{}{"from": "90", "to": "", "name": "Critical", "severity": "critical"},
+		},
+		"is_label_visible": true,
+		"y_axis_right": []interface{}{
+			map[string]interface{}{"zero": false, "min": "0", "max": "", "series": []interface{}{"Count"}},
+		},
+		"tooltip_mode": "single",
+	}
+
+	chartCfg, err := expandDashboardWidgetChartConfiguration(w, &dashboards.RawConfiguration{Text: "unused"}, "viz.line")
+	assert.NoError(t, err)
+
+	raw, err := json.Marshal(chartCfg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"text": "unused",
+		"thresholds": {"isLabelVisible": true, "thresholds": [{"from": 1, "to": 2, "name": "Slow", "severity": "warning"}, {"from": 90, "name": "Critical", "severity": "critical"}]},
+		"yAxisRight": {"zero": false, "min": 0, "series": [{"name": "Count"}]},
+		"tooltip": {"mode": "single"}
+	}`, string(raw))
+}
+
+func TestFlattenDashboardEventFeedAndScatter(t *testing.T) {
+	eventFeed := entities.DashboardWidget{
+		ID: "abcde",
+		Visualization: entities.DashboardWidgetVisualization{
+			ID: "viz.event-feed",
+		},
+		RawConfiguration: []byte(`{"nrqlQueries": [{"accountId": 1606862, "query": "FROM Deployment SELECT *"}], "legend": {"enabled": true}}`),
+	}
+	widgetType, out := flattenDashboardWidget(&eventFeed, "abcde")
+	assert.Equal(t, "widget_event_feed", widgetType)
+	assert.Contains(t, out, "nrql_query")
+	assert.NotContains(t, out, "legend_enabled")
+
+	scatter := entities.DashboardWidget{
+		ID: "fghij",
+		Visualization: entities.DashboardWidgetVisualization{
+			ID: "viz.scatter",
+		},
+		LinkedEntities: []entities.EntityOutlineInterface{&entities.DashboardEntityOutline{GUID: "abcde"}},
+		RawConfiguration: []byte(`{"nrqlQueries": [{"accountId": 1606862, "query": "FROM Transaction SELECT duration, databaseDuration"}],
+			"yAxisLeft": {"zero": true}, "tooltip": {"mode": "single"}, "nullValues": {"nullValue": "zero"}}`),
+	}
+	widgetType, out = flattenDashboardWidget(&scatter, "abcde")
+	assert.Equal(t, "widget_scatter", widgetType)
+	assert.Contains(t, out, "nrql_query")
+	assert.Equal(t, true, out["filter_current_dashboard"])
+	assert.Equal(t, true, *out["y_axis_left_zero"].(*bool))
+	assert.Equal(t, "single", out["tooltip_mode"])
+	assert.NotContains(t, out, "null_values")
+}
+
+func TestExpandDashboardScatterWidget(t *testing.T) {
+	w := map[string]interface{}{
+		"title":            "Durations",
+		"y_axis_left_zero": true,
+		"y_axis_left_min":  0.0,
+		"y_axis_left_max":  10.0,
+		"tooltip_mode":     "hidden",
+	}
+
+	widget, rawConfiguration, err := expandDashboardWidgetInput(w, nil, "viz.scatter")
+	assert.NoError(t, err)
+	assert.Equal(t, "viz.scatter", widget.Visualization.ID)
+
+	chartCfg, err := expandDashboardWidgetChartConfiguration(w, rawConfiguration, "viz.scatter")
+	assert.NoError(t, err)
+
+	raw, err := json.Marshal(chartCfg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"yAxisLeft": {"zero": true, "min": 0, "max": 10}, "tooltip": {"mode": "hidden"}}`, string(raw))
+}
+
+func TestSetDashboardWidgetFilterCurrentDashboardLinkedEntity(t *testing.T) {
+	query := []interface{}{map[string]interface{}{"query": "SELECT count(*) FROM Transaction FACET appName"}}
+	d := schema.TestResourceDataRaw(t, resourceNewRelicOneDashboard().Schema, map[string]interface{}{
+		"name": "Sample",
+		"page": []interface{}{
+			map[string]interface{}{
+				"name":        "Overview",
+				"auto_layout": true,
+				"widget_bar": []interface{}{
+					map[string]interface{}{"title": "Applications", "nrql_query": query},
+					map[string]interface{}{"title": "Applications", "nrql_query": query, "filter_current_dashboard": true},
+				},
+			},
+		},
+	})
+
+	filterWidgets, err := findDashboardWidgetFilterCurrentDashboard(d)
+	assert.NoError(t, err)
+	assert.Len(t, filterWidgets, 1)
+
+	// The page is given a GUID once the dashboard is created
+	pages := d.Get("page").([]interface{})
+	pages[0].(map[string]interface{})["guid"] = "MXxWSVp8REFTSEJPQVJEfDI"
+	assert.NoError(t, d.Set("page", pages))
+
+	assert.NoError(t, setDashboardWidgetFilterCurrentDashboardLinkedEntity(d, filterWidgets))
+	assert.Empty(t, d.Get("page.0.widget_bar.0.linked_entity_guids"))
+	assert.Equal(t, []interface{}{"MXxWSVp8REFTSEJPQVJEfDI"}, d.Get("page.0.widget_bar.1.linked_entity_guids"))
+}
+
+func TestSetDashboardWidgetFilterCurrentDashboardLinkedEntity_APIOrder(t *testing.T) {
+	query := []interface{}{map[string]interface{}{"query": "SELECT count(*) FROM Transaction FACET appName"}}
+	d := schema.TestResourceDataRaw(t, resourceNewRelicOneDashboard().Schema, map[string]interface{}{
+		"name": "Sample",
+		"page": []interface{}{
+			map[string]interface{}{
+				"name": "Overview",
+				"widget_bar": []interface{}{
+					map[string]interface{}{"title": "Applications", "row": 1, "column": 1, "nrql_query": query},
+					map[string]interface{}{"title": "Hosts", "row": 1, "column": 5, "nrql_query": query, "filter_current_dashboard": true},
+				},
+			},
+		},
+	})
+
+	filterWidgets, err := findDashboardWidgetFilterCurrentDashboard(d)
+	assert.NoError(t, err)
+	assert.Len(t, filterWidgets, 1)
+
+	// The dashboard is read back with its widgets in a different order than configured
+	pages := d.Get("page").([]interface{})
+	page := pages[0].(map[string]interface{})
+	widgets := page["widget_bar"].([]interface{})
+	page["widget_bar"] = []interface{}{widgets[1], widgets[0]}
+	page["guid"] = "MXxWSVp8REFTSEJPQVJEfDI"
+	assert.NoError(t, d.Set("page", pages))
+
+	assert.NoError(t, setDashboardWidgetFilterCurrentDashboardLinkedEntity(d, filterWidgets))
+	assert.Equal(t, "Hosts", d.Get("page.0.widget_bar.0.title"))
+	assert.Equal(t, []interface{}{"MXxWSVp8REFTSEJPQVJEfDI"}, d.Get("page.0.widget_bar.0.linked_entity_guids"))
+	assert.Empty(t, d.Get("page.0.widget_bar.1.linked_entity_guids"))
+}
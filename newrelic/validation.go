@@ -2,6 +2,8 @@ package newrelic
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,6 +43,21 @@ func float64Gte(gte float64) schema.SchemaValidateFunc {
 	}
 }
 
+// Validates optional numbers stored as strings, so that zero can be told apart from an unset value.
+func validateFloatString(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := strconv.ParseFloat(v, 64); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a number, got %q", k, v))
+	}
+
+	return
+}
+
 func intInSlice(valid []int) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (s []string, es []error) {
 		v, ok := i.(int)
@@ -59,3 +76,92 @@ func intInSlice(valid []int) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// Block helpers supported by New Relic templates.
+var handlebarsBuiltInBlockHelpers = []string{"if", "unless", "each", "with"}
+
+// Variables available to the title template of an alert condition.
+var nrqlConditionTitleTemplateVariables = []string{
+	"accountId",
+	"conditionId",
+	"conditionName",
+	"entity.guid",
+	"entity.name",
+	"entity.type",
+	"policyId",
+	"policyName",
+	"priority",
+}
+
+// Prefixes of the variables available to the title template of an alert condition,
+// e.g. `{{tags.host}}` for the value of the `host` tag of the incident.
+var nrqlConditionTitleTemplateVariablePrefixes = []string{
+	"tags.",
+	"accumulations.",
+}
+
+// Validates the title template of a NRQL alert condition. Malformed Handlebars is an error, while
+// helpers and variables that are not known to be available when an incident opens are warnings,
+// as New Relic may add some that the provider doesn't know about.
+func validateNrqlConditionTitleTemplate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("expected %s to not be empty", k))
+		return
+	}
+
+	nodes, err := parseHandlebarsTemplate(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+		return
+	}
+
+	err = walkHandlebarsNodes(nodes, false, func(node *handlebarsNode, scoped bool) error {
+		if node.Kind == handlebarsBlockNode && !stringInSlice(handlebarsBuiltInBlockHelpers, node.Name) {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown block helper `%s` at line %d, column %d, expected one of %v", k, node.Name, node.Line, node.Column, handlebarsBuiltInBlockHelpers))
+		}
+
+		if node.Kind == handlebarsMustacheNode && node.IsHelperCall() {
+			warnings = append(warnings, fmt.Sprintf("%s: unknown helper `%s` at line %d, column %d", k, node.Name, node.Line, node.Column))
+			return nil
+		}
+
+		if scoped {
+			return nil
+		}
+
+		for _, path := range handlebarsNodePaths(node) {
+			if !isHandlebarsContextPath(path) && !isNrqlConditionTitleTemplateVariable(path) {
+				warnings = append(warnings, fmt.Sprintf("%s: unknown variable `%s` at line %d, column %d, expected one of %v or a variable starting with %v",
+					k, path, node.Line, node.Column, nrqlConditionTitleTemplateVariables, nrqlConditionTitleTemplateVariablePrefixes))
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+
+	return
+}
+
+func isNrqlConditionTitleTemplateVariable(path string) bool {
+	if stringInSlice(nrqlConditionTitleTemplateVariables, path) {
+		return true
+	}
+
+	for _, prefix := range nrqlConditionTitleTemplateVariablePrefixes {
+		if strings.HasPrefix(path, prefix) && len(path) > len(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
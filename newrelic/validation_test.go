@@ -60,6 +60,71 @@ func TestValidationIntInInSlice(t *testing.T) {
 	})
 }
 
+func TestValidationNrqlConditionTitleTemplate(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "{{conditionName}} on {{tags.host}} ({{ priority }})",
+			f:   validateNrqlConditionTitleTemplate,
+		},
+		{
+			val: "{{#if entity.name}}{{entity.name}}{{else}}{{policyName}}{{/if}}",
+			f:   validateNrqlConditionTitleTemplate,
+		},
+		{
+			val: "Incident {{#each accumulations.tag.host}}{{this}} {{/each}}",
+			f:   validateNrqlConditionTitleTemplate,
+		},
+		{
+			val:         "{{conditionName} high latency",
+			f:           validateNrqlConditionTitleTemplate,
+			expectedErr: regexp.MustCompile("line 1, column 1: `{{` is never closed with `}}`"),
+		},
+		{
+			val:         "{{#if tags.host}}{{tags.host}}",
+			f:           validateNrqlConditionTitleTemplate,
+			expectedErr: regexp.MustCompile("block `if` is never closed"),
+		},
+		{
+			val:         "{{#if tags.host}}{{/unless}}",
+			f:           validateNrqlConditionTitleTemplate,
+			expectedErr: regexp.MustCompile("closing block `unless` does not match opening block `if`"),
+		},
+		{
+			val:          "{{conditionNme}} is open",
+			f:            validateNrqlConditionTitleTemplate,
+			expectedWarn: regexp.MustCompile("unknown variable `conditionNme` at line 1, column 1"),
+		},
+		{
+			val:          "{{upper conditionName}}",
+			f:            validateNrqlConditionTitleTemplate,
+			expectedWarn: regexp.MustCompile("unknown helper `upper`"),
+		},
+		{
+			val:         "   ",
+			f:           validateNrqlConditionTitleTemplate,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to not be empty`),
+		},
+	})
+}
+
+func TestValidationFloatString(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0",
+			f:   validateFloatString,
+		},
+		{
+			val: "-2.5",
+			f:   validateFloatString,
+		},
+		{
+			val:         "ninety",
+			f:           validateFloatString,
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be a number, got "ninety"`),
+		},
+	})
+}
+
 func TestValidationFloat64Gte(t *testing.T) {
 	runTestCases(t, []testCase{
 		{
@@ -2,6 +2,8 @@ package newrelic
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/newrelic/newrelic-client-go/v2/pkg/workflows"
 
@@ -43,3 +45,88 @@ func buildAiWorkflowsDeleteResponseError(errors []workflows.AiWorkflowsDeleteRes
 	}
 	return diagErrors
 }
+
+// workflowFilterPredicate is a predicate of the issues filter of a workflow.
+type workflowFilterPredicate struct {
+	Attribute string
+	Operator  workflows.AiWorkflowsOperator
+	Values    []string
+}
+
+// Reports whether all the predicates of an issues filter match the attributes of an issue,
+// returning the indexes of the predicates which do not match.
+func evaluateWorkflowFilter(predicates []workflowFilterPredicate, attributes map[string][]string) (bool, []int) {
+	failed := []int{}
+
+	for i, predicate := range predicates {
+		if !evaluateWorkflowFilterPredicate(predicate, attributes) {
+			failed = append(failed, i)
+		}
+	}
+
+	return len(failed) == 0, failed
+}
+
+// Evaluates a predicate against the attributes of an issue. Issue attributes may hold several
+// values, e.g. `labels.policyIds`, in which case a positive operator matches when any value
+// of the attribute matches any value of the predicate, and a negative operator matches when
+// its positive counterpart does not.
+func evaluateWorkflowFilterPredicate(predicate workflowFilterPredicate, attributes map[string][]string) bool {
+	values := attributes[predicate.Attribute]
+
+	anyMatch := func(match func(value string, expected string) bool) bool {
+		for _, value := range values {
+			for _, expected := range predicate.Values {
+				if match(value, expected) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	equals := func(value string, expected string) bool { return value == expected }
+
+	compare := func(match func(value float64, expected float64) bool) func(string, string) bool {
+		return func(value string, expected string) bool {
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return false
+			}
+			e, err := strconv.ParseFloat(expected, 64)
+			if err != nil {
+				return false
+			}
+			return match(v, e)
+		}
+	}
+
+	switch predicate.Operator {
+	case workflows.AiWorkflowsOperatorTypes.EXACTLY_MATCHES, workflows.AiWorkflowsOperatorTypes.IS:
+		return anyMatch(equals)
+	case workflows.AiWorkflowsOperatorTypes.DOES_NOT_EXACTLY_MATCH, workflows.AiWorkflowsOperatorTypes.IS_NOT:
+		return !anyMatch(equals)
+	case workflows.AiWorkflowsOperatorTypes.CONTAINS:
+		return anyMatch(strings.Contains)
+	case workflows.AiWorkflowsOperatorTypes.DOES_NOT_CONTAIN:
+		return !anyMatch(strings.Contains)
+	case workflows.AiWorkflowsOperatorTypes.STARTS_WITH:
+		return anyMatch(strings.HasPrefix)
+	case workflows.AiWorkflowsOperatorTypes.ENDS_WITH:
+		return anyMatch(strings.HasSuffix)
+	case workflows.AiWorkflowsOperatorTypes.EQUAL:
+		return anyMatch(compare(func(v, e float64) bool { return v == e }))
+	case workflows.AiWorkflowsOperatorTypes.DOES_NOT_EQUAL:
+		return !anyMatch(compare(func(v, e float64) bool { return v == e }))
+	case workflows.AiWorkflowsOperatorTypes.GREATER_THAN:
+		return anyMatch(compare(func(v, e float64) bool { return v > e }))
+	case workflows.AiWorkflowsOperatorTypes.GREATER_OR_EQUAL:
+		return anyMatch(compare(func(v, e float64) bool { return v >= e }))
+	case workflows.AiWorkflowsOperatorTypes.LESS_THAN:
+		return anyMatch(compare(func(v, e float64) bool { return v < e }))
+	case workflows.AiWorkflowsOperatorTypes.LESS_OR_EQUAL:
+		return anyMatch(compare(func(v, e float64) bool { return v <= e }))
+	}
+
+	return false
+}